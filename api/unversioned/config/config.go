@@ -17,24 +17,30 @@ cacheDir: /var/lib/trivy
 dbRepo: ghcr.io/aquasecurity/trivy-db
 deleteFailedImages: true
 deleteEOLImages: true
+concurrency: 1
+cacheResults: true
 vulnerabilities:
   ignoreUnfixed: false
   types:
     - os
     - library
-securityChecks: # need to be documented; determined by trivy, not us
-  - vuln
-severities:
-  - CRITICAL
-  - HIGH
-  - MEDIUM
-  - LOW
-ignoredStatuses:
+  securityChecks: # need to be documented; determined by trivy, not us
+    - vuln
+  severities:
+    - CRITICAL
+    - HIGH
+    - MEDIUM
+    - LOW
+  ignoredStatuses:
 `
 
 type Manager struct {
 	mtx sync.Mutex
 	cfg *unversioned.EraserConfig
+	// the configuration from the file, kept aside while cfg holds the
+	// configuration of an EraserConfig resource
+	fileCfg     *unversioned.EraserConfig
+	subscribers []chan struct{}
 }
 
 func (m *Manager) Read() (unversioned.EraserConfig, error) {
@@ -49,6 +55,9 @@ func (m *Manager) Read() (unversioned.EraserConfig, error) {
 	return cfg, nil
 }
 
+// Update replaces the configuration read from the file. While an
+// EraserConfig resource overrides it, the new configuration only takes
+// effect once the override is cleared.
 func (m *Manager) Update(newC *unversioned.EraserConfig) error {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
@@ -61,10 +70,88 @@ func (m *Manager) Update(newC *unversioned.EraserConfig) error {
 		return fmt.Errorf("new configuration is nil, aborting")
 	}
 
+	if m.fileCfg != nil {
+		*m.fileCfg = *newC
+		return nil
+	}
+
 	*m.cfg = *newC
+	m.notify()
+
 	return nil
 }
 
+// Override replaces the configuration with the one from an EraserConfig
+// resource, until ClearOverride is called.
+func (m *Manager) Override(newC *unversioned.EraserConfig) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.cfg == nil {
+		return fmt.Errorf("ConfigManager configuration is nil, aborting")
+	}
+
+	if newC == nil {
+		return fmt.Errorf("new configuration is nil, aborting")
+	}
+
+	if m.fileCfg == nil {
+		fileCfg := *m.cfg
+		m.fileCfg = &fileCfg
+	}
+
+	*m.cfg = *newC
+	m.notify()
+
+	return nil
+}
+
+// ClearOverride goes back to the configuration from the file.
+func (m *Manager) ClearOverride() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.fileCfg == nil {
+		return
+	}
+
+	*m.cfg = *m.fileCfg
+	m.fileCfg = nil
+	m.notify()
+}
+
+// Overridden reports whether the configuration comes from an EraserConfig
+// resource.
+func (m *Manager) Overridden() bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.fileCfg != nil
+}
+
+func (m *Manager) notify() {
+	for _, ch := range m.subscribers {
+		// a pending notification already covers this update
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel that receives a value after the configuration
+// is updated. Notifications are not queued: a subscriber that is busy while
+// several updates happen is notified once, and should Read the latest
+// configuration.
+func (m *Manager) Subscribe() <-chan struct{} {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	ch := make(chan struct{}, 1)
+	m.subscribers = append(m.subscribers, ch)
+	return ch
+}
+
 func NewManager(cfg *unversioned.EraserConfig) *Manager {
 	return &Manager{
 		mtx: sync.Mutex{},
@@ -75,9 +162,16 @@ func NewManager(cfg *unversioned.EraserConfig) *Manager {
 const (
 	noDelay = unversioned.Duration(0)
 	oneDay  = unversioned.Duration(time.Hour * 24)
+	oneHour = unversioned.Duration(time.Hour)
+
+	fiveMinutes = unversioned.Duration(5 * time.Minute)
 )
 
 func Default() *unversioned.EraserConfig {
+	// the configuration is unmarshaled into the defaults, which must not
+	// overwrite the default scanner configuration itself
+	scannerConfig := defaultScannerConfig
+
 	return &unversioned.EraserConfig{
 		Manager: unversioned.ManagerConfig{
 			Runtime: unversioned.RuntimeSpec{
@@ -89,6 +183,9 @@ func Default() *unversioned.EraserConfig {
 			Scheduling: unversioned.ScheduleConfig{
 				RepeatInterval:   unversioned.Duration(oneDay),
 				BeginImmediately: true,
+				DiskPressure: unversioned.DiskPressureTrigger{
+					Cooldown: oneHour,
+				},
 			},
 			Profile: unversioned.ProfileConfig{
 				Enabled: false,
@@ -133,7 +230,7 @@ func Default() *unversioned.EraserConfig {
 				Enabled: false,
 				ContainerConfig: unversioned.ContainerConfig{
 					Image: unversioned.RepoTag{
-						Repo: repo("eraser-trivy-scanner"),
+						Repo: repo(trivyScannerRepo),
 						Tag:  version.BuildVersion,
 					},
 					Request: unversioned.ResourceRequirements{
@@ -144,24 +241,29 @@ func Default() *unversioned.EraserConfig {
 						Mem: resource.MustParse("2Gi"),
 						CPU: resource.MustParse("1500m"),
 					},
-					Config:  &defaultScannerConfig,
+					Config:  &scannerConfig,
 					Volumes: []v1.Volume{},
 				},
 			},
-			Remover: unversioned.ContainerConfig{
-				Image: unversioned.RepoTag{
-					Repo: repo("remover"),
-					Tag:  version.BuildVersion,
-				},
-				Request: unversioned.ResourceRequirements{
-					Mem: resource.MustParse("25Mi"),
-					CPU: resource.MustParse("7m"),
-				},
-				Limit: unversioned.ResourceRequirements{
-					Mem: resource.MustParse("30Mi"),
-					CPU: resource.Quantity{},
+			Remover: unversioned.RemoverConfig{
+				ContainerConfig: unversioned.ContainerConfig{
+					Image: unversioned.RepoTag{
+						Repo: repo("remover"),
+						Tag:  version.BuildVersion,
+					},
+					Request: unversioned.ResourceRequirements{
+						Mem: resource.MustParse("25Mi"),
+						CPU: resource.MustParse("7m"),
+					},
+					Limit: unversioned.ResourceRequirements{
+						Mem: resource.MustParse("30Mi"),
+						CPU: resource.Quantity{},
+					},
+					Config: nil,
 				},
-				Config: nil,
+				Concurrency:      4,
+				DeletesPerSecond: 0,
+				Timeout:          fiveMinutes,
 			},
 		},
 	}
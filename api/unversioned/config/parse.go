@@ -0,0 +1,73 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"sigs.k8s.io/yaml"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	"github.com/eraser-dev/eraser/api/v1alpha1"
+	v1alpha1Config "github.com/eraser-dev/eraser/api/v1alpha1/config"
+	"github.com/eraser-dev/eraser/api/v1alpha2"
+	v1alpha2Config "github.com/eraser-dev/eraser/api/v1alpha2/config"
+	"github.com/eraser-dev/eraser/api/v1alpha3"
+	v1alpha3Config "github.com/eraser-dev/eraser/api/v1alpha3/config"
+)
+
+type apiVersion struct {
+	APIVersion string `json:"apiVersion"`
+}
+
+type convertFunc[T any] func(*T, *unversioned.EraserConfig, conversion.Scope) error
+
+// Parse reads an EraserConfig of any supported version from YAML or JSON.
+// Fields that are not set take the defaults of that version.
+func Parse(b []byte) (*unversioned.EraserConfig, error) {
+	var av apiVersion
+	if err := yaml.Unmarshal(b, &av); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal configuration: %w", err)
+	}
+
+	switch av.APIVersion {
+	case v1alpha1.GroupVersion.String():
+		return parseVersion(b, v1alpha1Config.Default(), v1alpha1.Convert_v1alpha1_EraserConfig_To_unversioned_EraserConfig)
+	case v1alpha2.GroupVersion.String():
+		return parseVersion(b, v1alpha2Config.Default(), v1alpha2.Convert_v1alpha2_EraserConfig_To_unversioned_EraserConfig)
+	case v1alpha3.GroupVersion.String():
+		return parseVersion(b, v1alpha3Config.Default(), v1alpha3.Convert_v1alpha3_EraserConfig_To_unversioned_EraserConfig)
+	default:
+		return nil, fmt.Errorf("unknown api version %q", av.APIVersion)
+	}
+}
+
+// ParseResource reads an EraserConfig resource the same way as the
+// configuration file, so that fields that are not set take their defaults.
+func ParseResource(obj *v1alpha3.EraserConfig) (*unversioned.EraserConfig, error) {
+	obj = obj.DeepCopy()
+	obj.TypeMeta = metav1.TypeMeta{APIVersion: v1alpha3.GroupVersion.String(), Kind: "EraserConfig"}
+
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(b)
+}
+
+func parseVersion[T any](b []byte, defaults *T, convert convertFunc[T]) (*unversioned.EraserConfig, error) {
+	cfg := defaults
+
+	if err := yaml.Unmarshal(b, cfg); err != nil {
+		return nil, fmt.Errorf("configuration is invalid: %w", err)
+	}
+
+	var unv unversioned.EraserConfig
+	if err := convert(cfg, &unv, nil); err != nil {
+		return nil, err
+	}
+
+	return &unv, nil
+}
@@ -0,0 +1,73 @@
+package config
+
+import "github.com/eraser-dev/eraser/api/unversioned"
+
+// trivyScannerRepo is the name of the repository of the trivy scanner image
+// eraser provides.
+const trivyScannerRepo = "eraser-trivy-scanner"
+
+type (
+	// TrivyConfig is the configuration of the eraser-trivy-scanner, given in
+	// components.scanner.config.
+	TrivyConfig struct {
+		Runtime            unversioned.RuntimeSpec `json:"runtime,omitempty"`
+		CacheDir           string                  `json:"cacheDir,omitempty"`
+		DBRepo             string                  `json:"dbRepo,omitempty"`
+		DeleteFailedImages bool                    `json:"deleteFailedImages,omitempty"`
+		DeleteEOLImages    bool                    `json:"deleteEOLImages,omitempty"`
+		Concurrency        int                     `json:"concurrency,omitempty"`
+		CacheResults       bool                    `json:"cacheResults,omitempty"`
+		Vulnerabilities    TrivyVulnConfig         `json:"vulnerabilities,omitempty"`
+		Timeout            TrivyTimeoutConfig      `json:"timeout,omitempty"`
+		Allowlist          []TrivySuppression      `json:"allowlist,omitempty"`
+		Policy             TrivyPolicyConfig       `json:"policy,omitempty"`
+	}
+
+	// TrivyPolicyConfig decides which vulnerabilities make an image non-compliant.
+	// By default, any vulnerability found does.
+	TrivyPolicyConfig struct {
+		// Only vulnerabilities with a CVSS score at least this high count.
+		// Vulnerabilities without a score then do not count.
+		MinCVSSScore float64 `json:"minCVSSScore,omitempty"`
+		// Only vulnerabilities with a fixed version count.
+		FixedOnly bool `json:"fixedOnly,omitempty"`
+		// Only vulnerabilities published at least this long ago count.
+		// Vulnerabilities without a publication date then do not count.
+		MinPublishedAge unversioned.Duration `json:"minPublishedAge,omitempty"`
+		// Number of vulnerabilities of a severity an image may have and
+		// still be compliant, by severity. Any vulnerability of a severity
+		// that is not listed makes an image non-compliant.
+		MaxCount map[string]int `json:"maxCount,omitempty"`
+	}
+
+	// TrivySuppression accepts the risk of vulnerabilities, which then do not make
+	// images non-compliant. A vulnerability is suppressed when it matches
+	// all the criteria that are set, and one of ids or packages must be.
+	TrivySuppression struct {
+		// Vulnerability IDs, such as CVE-2023-1234.
+		IDs []string `json:"ids,omitempty"`
+		// Names of the vulnerable packages.
+		Packages []string `json:"packages,omitempty"`
+		// Image patterns of the repositories the rule applies to. A
+		// repository matches all its tags and digests. Empty for all images.
+		Repositories []string `json:"repositories,omitempty"`
+		// Date, such as 2024-12-31, or RFC 3339 time from which the rule no
+		// longer applies. Empty for a rule that does not expire.
+		Expires string `json:"expires,omitempty"`
+		// Why the risk is accepted, which is logged with each suppression.
+		Reason string `json:"reason,omitempty"`
+	}
+
+	TrivyVulnConfig struct {
+		IgnoreUnfixed   bool     `json:"ignoreUnfixed,omitempty"`
+		Types           []string `json:"types,omitempty"`
+		SecurityChecks  []string `json:"securityChecks,omitempty"`
+		Severities      []string `json:"severities,omitempty"`
+		IgnoredStatuses []string `json:"ignoredStatuses,omitempty"`
+	}
+
+	TrivyTimeoutConfig struct {
+		Total    unversioned.Duration `json:"total,omitempty"`
+		PerImage unversioned.Duration `json:"perImage,omitempty"`
+	}
+)
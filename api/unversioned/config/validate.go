@@ -0,0 +1,156 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	"github.com/eraser-dev/eraser/pkg/schedule"
+)
+
+// Validate checks the parts of a configuration that are not checked when it
+// is parsed. The errors of all the invalid parts are returned together.
+func Validate(cfg *unversioned.EraserConfig) error {
+	var errs []error
+
+	if _, err := schedule.New(&cfg.Manager.Scheduling); err != nil {
+		errs = append(errs, fmt.Errorf("invalid scheduling configuration: %w", err))
+	}
+
+	if err := validateDiskPressure(&cfg.Manager.Scheduling.DiskPressure); err != nil {
+		errs = append(errs, fmt.Errorf("invalid scheduling.diskPressure: %w", err))
+	}
+
+	if err := validateRuntime(&cfg.Manager.Runtime); err != nil {
+		errs = append(errs, fmt.Errorf("invalid runtime configuration: %w", err))
+	}
+
+	if err := validateNodeFilter(&cfg.Manager.NodeFilter); err != nil {
+		errs = append(errs, fmt.Errorf("invalid node filter: %w", err))
+	}
+
+	if r := cfg.Manager.ImageJob.SuccessRatio; r < 0 || r > 1 {
+		errs = append(errs, fmt.Errorf("invalid imageJob.successRatio %v: must be between 0 and 1", r))
+	}
+
+	if d := cfg.Manager.CollectorPolicy.UnusedFor; d < 0 {
+		errs = append(errs, fmt.Errorf("invalid collectorPolicy.unusedFor %v: must not be negative", time.Duration(d)))
+	}
+
+	if n := cfg.Manager.CollectorPolicy.KeepLast; n < 0 {
+		errs = append(errs, fmt.Errorf("invalid collectorPolicy.keepLast %d: must not be negative", n))
+	}
+
+	if err := validateDiskUsage(&cfg.Manager.CollectorPolicy.DiskUsage); err != nil {
+		errs = append(errs, fmt.Errorf("invalid collectorPolicy.diskUsage: %w", err))
+	}
+
+	if err := validateRemover(&cfg.Components.Remover); err != nil {
+		errs = append(errs, fmt.Errorf("invalid components.remover: %w", err))
+	}
+
+	if c := cfg.Components.Scanner.Config; c != nil {
+		if err := validateScannerConfig(cfg.Components.Scanner.Image.Repo, *c); err != nil {
+			errs = append(errs, fmt.Errorf("invalid scanner config: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validateScannerConfig checks that the scanner configuration is YAML. The
+// configuration of the trivy scanner eraser provides must also match its
+// schema, without unknown fields; custom scanners define their own.
+func validateScannerConfig(repo, c string) error {
+	if path.Base(repo) != trivyScannerRepo {
+		var scannerConfig map[string]interface{}
+		return yaml.Unmarshal([]byte(c), &scannerConfig)
+	}
+
+	var scannerConfig TrivyConfig
+	return yaml.UnmarshalStrict([]byte(c), &scannerConfig)
+}
+
+func validateRuntime(rs *unversioned.RuntimeSpec) error {
+	switch rs.Name {
+	case unversioned.RuntimeContainerd, unversioned.RuntimeDockerShim, unversioned.RuntimeCrio:
+	default:
+		return fmt.Errorf("invalid runtime %q: valid names are %s, %s, %s", rs.Name,
+			unversioned.RuntimeContainerd, unversioned.RuntimeDockerShim, unversioned.RuntimeCrio)
+	}
+
+	u, err := url.Parse(rs.Address)
+	if err != nil {
+		return err
+	}
+
+	switch u.Scheme {
+	case "tcp", "unix":
+	default:
+		return fmt.Errorf("invalid address %q: valid schemes are `tcp` and `unix`", rs.Address)
+	}
+
+	return nil
+}
+
+func validateNodeFilter(nf *unversioned.NodeFilterConfig) error {
+	switch nf.Type {
+	case "include", "exclude":
+	default:
+		return fmt.Errorf("invalid type %q: must be include or exclude", nf.Type)
+	}
+
+	for _, s := range nf.Selectors {
+		if _, err := labels.Parse(s); err != nil {
+			return fmt.Errorf("invalid selector %q: %w", s, err)
+		}
+	}
+
+	return nil
+}
+
+func validateDiskUsage(du *unversioned.DiskUsagePolicy) error {
+	high, low := du.HighThresholdPercent, du.LowThresholdPercent
+	switch {
+	case high < 0 || high > 100:
+		return fmt.Errorf("highThresholdPercent %d must be between 0 and 100", high)
+	case low < 0 || low > 100:
+		return fmt.Errorf("lowThresholdPercent %d must be between 0 and 100", low)
+	case high > 0 && low > high:
+		return fmt.Errorf("lowThresholdPercent %d must not be above highThresholdPercent %d", low, high)
+	}
+
+	return nil
+}
+
+func validateDiskPressure(dp *unversioned.DiskPressureTrigger) error {
+	if dp.Cooldown < 0 {
+		return fmt.Errorf("cooldown %v must not be negative", time.Duration(dp.Cooldown))
+	}
+
+	if p := dp.ImageFsUsagePercent; p < 0 || p > 100 {
+		return fmt.Errorf("imageFsUsagePercent %d must be between 0 and 100", p)
+	}
+
+	return nil
+}
+
+func validateRemover(rc *unversioned.RemoverConfig) error {
+	if rc.Concurrency < 0 {
+		return fmt.Errorf("concurrency %d must not be negative", rc.Concurrency)
+	}
+	if rc.DeletesPerSecond < 0 {
+		return fmt.Errorf("deletesPerSecond %d must not be negative", rc.DeletesPerSecond)
+	}
+	if rc.Timeout < 0 {
+		return fmt.Errorf("timeout %v must not be negative", time.Duration(rc.Timeout))
+	}
+
+	return nil
+}
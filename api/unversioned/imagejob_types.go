@@ -25,6 +25,14 @@ type Image struct {
 	ImageID string   `json:"image_id"`
 	Names   []string `json:"names,omitempty"`
 	Digests []string `json:"digests,omitempty"`
+	// When a container last used the image, as recorded by the collector on
+	// the node. Unset if no container has used it since it was first seen.
+	LastUsed *metav1.Time `json:"lastUsed,omitempty"`
+	// When the image was created, as reported by the runtime. Only set by
+	// the collector when images are retained by age.
+	Created *metav1.Time `json:"created,omitempty"`
+	// Size of the image in bytes, as reported by the runtime
+	Size int64 `json:"size,omitempty"`
 }
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -39,6 +47,17 @@ const (
 	PhaseFailed    JobPhase = "Failed"
 )
 
+// Types of the conditions of ImageJobs and ImageLists.
+const (
+	// ConditionReady is true once the job completed on enough nodes to meet
+	// the success ratio.
+	ConditionReady = "Ready"
+	// ConditionProgressing is true while the job runs.
+	ConditionProgressing = "Progressing"
+	// ConditionDegraded is true when the job failed or could not be started.
+	ConditionDegraded = "Degraded"
+)
+
 // ImageJobStatus defines the observed state of ImageJob.
 type ImageJobStatus struct {
 	// number of pods that failed
@@ -53,11 +72,22 @@ type ImageJobStatus struct {
 	// number of nodes that were skipped e.g. because they are not a linux node
 	Skipped int `json:"skipped"`
 
+	// bytes freed by the images removed on all nodes
+	ReclaimedBytes int64 `json:"reclaimedBytes,omitempty"`
+
 	// job running, successfully completed, or failed
 	Phase JobPhase `json:"phase"`
 
 	// Time to delay deletion until
 	DeleteAfter *metav1.Time `json:"deleteAfter,omitempty"`
+
+	// Conditions of the job: Progressing while it runs, Ready once it
+	// completed on enough nodes and Degraded when it failed or could not be
+	// started.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // ImageJob is the Schema for the imagejobs API.
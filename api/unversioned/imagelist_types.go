@@ -20,8 +20,13 @@ import (
 
 // ImageListSpec defines the desired state of ImageList.
 type ImageListSpec struct {
-	// The list of non-compliant images to delete if non-running.
+	// The list of non-compliant images to delete if non-running: image
+	// references, image IDs, globs, regular expressions between slashes, or
+	// "*" for all images.
 	Images []string `json:"images"`
+	// If set, the images are not removed. The images that would have been
+	// removed are reported in the status instead.
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
 // ImageListStatus defines the observed state of ImageList.
@@ -34,6 +39,103 @@ type ImageListStatus struct {
 	Failed int64 `json:"failed"`
 	// Number of nodes that were skipped due to a skip selector
 	Skipped int64 `json:"skipped"`
+	// Bytes freed by the images removed on all nodes
+	ReclaimedBytes int64 `json:"reclaimedBytes,omitempty"`
+	// Results of the job on each node it ran on. On large clusters, the
+	// per-image results and the exclusion counts are left out to keep the
+	// ImageList within the size the API server accepts, and so are the nodes
+	// that still do not fit.
+	Nodes []NodeResult `json:"nodes,omitempty"`
+	// Number of nodes left out of nodes to keep the status within the size
+	// the API server accepts
+	NodesOmitted int64 `json:"nodesOmitted,omitempty"`
+	// Conditions of the last job run for the list
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ImageResultType describes what happened to an image on a node.
+type ImageResultType string
+
+const (
+	ImageRemoved     ImageResultType = "Removed"
+	ImageRunning     ImageResultType = "Running"
+	ImageExcluded    ImageResultType = "Excluded"
+	ImageNotPresent  ImageResultType = "NotPresent"
+	ImageError       ImageResultType = "Error"
+	ImageWouldRemove ImageResultType = "WouldRemove"
+	ImageSkipped     ImageResultType = "Skipped"
+)
+
+// ImageResult is the outcome of removing a single image on a node.
+type ImageResult struct {
+	// The image as given in the list, or the image ID for images removed by
+	// "*" or a pattern
+	Image string `json:"image"`
+	// One of Removed, WouldRemove, Running, Excluded, NotPresent, Skipped or
+	// Error
+	Result ImageResultType `json:"result"`
+	// The error returned by the container runtime, if any
+	Message string `json:"message,omitempty"`
+	// The exclusion patterns that matched, for excluded images
+	ExcludedBy []string `json:"excludedBy,omitempty"`
+	// The glob or regular expression of the list that matched the image
+	MatchedBy string `json:"matchedBy,omitempty"`
+	// Size of the image in bytes, for images that were or would have been
+	// removed
+	Size int64 `json:"size,omitempty"`
+}
+
+// NodeResult summarizes the outcome of a job on a node.
+type NodeResult struct {
+	// Name of the node
+	Name string `json:"name"`
+	// Number of images removed from the node
+	Removed int64 `json:"removed"`
+	// Number of images that would have been removed, for dry runs
+	WouldRemove int64 `json:"wouldRemove,omitempty"`
+	// Number of images not removed because enough disk space was freed
+	Skipped int64 `json:"skipped,omitempty"`
+	// Number of images not removed because they are in use
+	Running int64 `json:"running"`
+	// Number of images not removed because they are excluded
+	Excluded int64 `json:"excluded"`
+	// Number of images that were not found on the node
+	NotPresent int64 `json:"notPresent"`
+	// Number of images whose removal failed
+	Errors int64 `json:"errors"`
+	// Bytes freed by the removed images, according to the runtime
+	ReclaimedBytes int64 `json:"reclaimedBytes,omitempty"`
+	// Bytes that would have been freed, for dry runs
+	WouldReclaimBytes int64 `json:"wouldReclaimBytes,omitempty"`
+	// Number of images protected by each exclusion pattern. On nodes with
+	// too many patterns to report, the ones that protected the fewest images
+	// are left out.
+	Exclusions map[string]int64 `json:"exclusions,omitempty"`
+	// Usage of the image filesystem before and after the job, if the job
+	// was gated on disk usage
+	ImageFs *ImageFsUsage `json:"imageFs,omitempty"`
+	// Result for each image. The list is cut short on nodes with too many
+	// images to report, the counts above are always complete.
+	Images []ImageResult `json:"images,omitempty"`
+	// Set if images was cut short
+	ImagesTruncated bool `json:"imagesTruncated,omitempty"`
+}
+
+// ImageFsUsage is the usage of the image filesystem of a node.
+type ImageFsUsage struct {
+	// Size of the filesystem
+	CapacityBytes int64 `json:"capacityBytes"`
+	// Bytes in use on the filesystem before the job removed images
+	UsedBytesBefore int64 `json:"usedBytesBefore"`
+	// Bytes in use on the filesystem after the job removed images
+	UsedBytesAfter int64 `json:"usedBytesAfter"`
+	// Bytes used by images before the job, according to the container runtime
+	ImageBytesBefore int64 `json:"imageBytesBefore"`
+	// Bytes used by images after the job, according to the container runtime
+	ImageBytesAfter int64 `json:"imageBytesAfter"`
 }
 
 // ImageList is the Schema for the imagelists API.
@@ -22,9 +22,41 @@ package unversioned
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackoutWindow) DeepCopyInto(out *BlackoutWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackoutWindow.
+func (in *BlackoutWindow) DeepCopy() *BlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorPolicy) DeepCopyInto(out *CollectorPolicy) {
+	*out = *in
+	out.DiskUsage = in.DiskUsage
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorPolicy.
+func (in *CollectorPolicy) DeepCopy() *CollectorPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Components) DeepCopyInto(out *Components) {
 	*out = *in
@@ -73,12 +105,44 @@ func (in *ContainerConfig) DeepCopy() *ContainerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskPressureTrigger) DeepCopyInto(out *DiskPressureTrigger) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskPressureTrigger.
+func (in *DiskPressureTrigger) DeepCopy() *DiskPressureTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskPressureTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskUsagePolicy) DeepCopyInto(out *DiskUsagePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskUsagePolicy.
+func (in *DiskUsagePolicy) DeepCopy() *DiskUsagePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskUsagePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EraserConfig) DeepCopyInto(out *EraserConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Manager.DeepCopyInto(&out.Manager)
 	in.Components.DeepCopyInto(&out.Components)
+	out.Status = in.Status
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfig.
@@ -99,6 +163,53 @@ func (in *EraserConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EraserConfigList) DeepCopyInto(out *EraserConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EraserConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfigList.
+func (in *EraserConfigList) DeepCopy() *EraserConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(EraserConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EraserConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EraserConfigStatus) DeepCopyInto(out *EraserConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfigStatus.
+func (in *EraserConfigStatus) DeepCopy() *EraserConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EraserConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -112,6 +223,14 @@ func (in *Image) DeepCopyInto(out *Image) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastUsed != nil {
+		in, out := &in.LastUsed, &out.LastUsed
+		*out = (*in).DeepCopy()
+	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
@@ -124,6 +243,21 @@ func (in *Image) DeepCopy() *Image {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFsUsage) DeepCopyInto(out *ImageFsUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFsUsage.
+func (in *ImageFsUsage) DeepCopy() *ImageFsUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFsUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageJob) DeepCopyInto(out *ImageJob) {
 	*out = *in
@@ -204,6 +338,13 @@ func (in *ImageJobStatus) DeepCopyInto(out *ImageJobStatus) {
 		in, out := &in.DeleteAfter, &out.DeleteAfter
 		*out = (*in).DeepCopy()
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageJobStatus.
@@ -286,6 +427,20 @@ func (in *ImageListStatus) DeepCopyInto(out *ImageListStatus) {
 		in, out := &in.Timestamp, &out.Timestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageListStatus.
@@ -298,11 +453,31 @@ func (in *ImageListStatus) DeepCopy() *ImageListStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageResult) DeepCopyInto(out *ImageResult) {
+	*out = *in
+	if in.ExcludedBy != nil {
+		in, out := &in.ExcludedBy, &out.ExcludedBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageResult.
+func (in *ImageResult) DeepCopy() *ImageResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagerConfig) DeepCopyInto(out *ManagerConfig) {
 	*out = *in
 	out.Runtime = in.Runtime
-	out.Scheduling = in.Scheduling
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
 	out.Profile = in.Profile
 	out.ImageJob = in.ImageJob
 	if in.PullSecrets != nil {
@@ -318,6 +493,7 @@ func (in *ManagerConfig) DeepCopyInto(out *ManagerConfig) {
 			(*out)[key] = val
 		}
 	}
+	out.CollectorPolicy = in.CollectorPolicy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerConfig.
@@ -350,6 +526,40 @@ func (in *NodeFilterConfig) DeepCopy() *NodeFilterConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeResult) DeepCopyInto(out *NodeResult) {
+	*out = *in
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ImageFs != nil {
+		in, out := &in.ImageFs, &out.ImageFs
+		*out = new(ImageFsUsage)
+		**out = **in
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ImageResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeResult.
+func (in *NodeResult) DeepCopy() *NodeResult {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OptionalContainerConfig) DeepCopyInto(out *OptionalContainerConfig) {
 	*out = *in
@@ -381,6 +591,22 @@ func (in *ProfileConfig) DeepCopy() *ProfileConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoverConfig) DeepCopyInto(out *RemoverConfig) {
+	*out = *in
+	in.ContainerConfig.DeepCopyInto(&out.ContainerConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoverConfig.
+func (in *RemoverConfig) DeepCopy() *RemoverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoTag) DeepCopyInto(out *RepoTag) {
 	*out = *in
@@ -431,6 +657,12 @@ func (in *RuntimeSpec) DeepCopy() *RuntimeSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduleConfig) DeepCopyInto(out *ScheduleConfig) {
 	*out = *in
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]BlackoutWindow, len(*in))
+		copy(*out, *in)
+	}
+	out.DiskPressure = in.DiskPressure
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleConfig.
@@ -0,0 +1,67 @@
+/*
+Copyright 2021.
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+    http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImageExclusionSpec defines the images that are never removed.
+type ImageExclusionSpec struct {
+	// The team or person responsible for the exclusion
+	Owner string `json:"owner,omitempty"`
+	// Why the images are excluded
+	Reason string `json:"reason,omitempty"`
+	// The images to exclude: image references, image IDs, globs or regular
+	// expressions between slashes
+	// +kubebuilder:validation:MinItems=1
+	// +listType=set
+	Patterns []string `json:"patterns"`
+}
+
+// ImageExclusionStatus defines the observed state of ImageExclusion.
+type ImageExclusionStatus struct {
+	// Number of images the patterns protected from removal in the last job,
+	// summed over all nodes. An image matched by several patterns is counted
+	// for each of them.
+	ProtectedImages int64 `json:"protectedImages"`
+	// Information when the last job was completed
+	LastRun *metav1.Time `json:"lastRun,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope="Cluster"
+// +kubebuilder:storageversion
+// ImageExclusion is the Schema for the imageexclusions API.
+type ImageExclusion struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageExclusionSpec   `json:"spec,omitempty"`
+	Status ImageExclusionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// ImageExclusionList contains a list of ImageExclusion.
+type ImageExclusionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ImageExclusion `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ImageExclusion{}, &ImageExclusionList{})
+}
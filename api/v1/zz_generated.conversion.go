@@ -46,6 +46,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImageFsUsage)(nil), (*unversioned.ImageFsUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ImageFsUsage_To_unversioned_ImageFsUsage(a.(*ImageFsUsage), b.(*unversioned.ImageFsUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.ImageFsUsage)(nil), (*ImageFsUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_ImageFsUsage_To_v1_ImageFsUsage(a.(*unversioned.ImageFsUsage), b.(*ImageFsUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ImageJob)(nil), (*unversioned.ImageJob)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ImageJob_To_unversioned_ImageJob(a.(*ImageJob), b.(*unversioned.ImageJob), scope)
 	}); err != nil {
@@ -116,6 +126,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImageResult)(nil), (*unversioned.ImageResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ImageResult_To_unversioned_ImageResult(a.(*ImageResult), b.(*unversioned.ImageResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.ImageResult)(nil), (*ImageResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_ImageResult_To_v1_ImageResult(a.(*unversioned.ImageResult), b.(*ImageResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeResult)(nil), (*unversioned.NodeResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NodeResult_To_unversioned_NodeResult(a.(*NodeResult), b.(*unversioned.NodeResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.NodeResult)(nil), (*NodeResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_NodeResult_To_v1_NodeResult(a.(*unversioned.NodeResult), b.(*NodeResult), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -123,6 +153,9 @@ func autoConvert_v1_Image_To_unversioned_Image(in *Image, out *unversioned.Image
 	out.ImageID = in.ImageID
 	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
 	out.Digests = *(*[]string)(unsafe.Pointer(&in.Digests))
+	out.LastUsed = (*metav1.Time)(unsafe.Pointer(in.LastUsed))
+	out.Created = (*metav1.Time)(unsafe.Pointer(in.Created))
+	out.Size = in.Size
 	return nil
 }
 
@@ -135,6 +168,9 @@ func autoConvert_unversioned_Image_To_v1_Image(in *unversioned.Image, out *Image
 	out.ImageID = in.ImageID
 	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
 	out.Digests = *(*[]string)(unsafe.Pointer(&in.Digests))
+	out.LastUsed = (*metav1.Time)(unsafe.Pointer(in.LastUsed))
+	out.Created = (*metav1.Time)(unsafe.Pointer(in.Created))
+	out.Size = in.Size
 	return nil
 }
 
@@ -143,6 +179,34 @@ func Convert_unversioned_Image_To_v1_Image(in *unversioned.Image, out *Image, s
 	return autoConvert_unversioned_Image_To_v1_Image(in, out, s)
 }
 
+func autoConvert_v1_ImageFsUsage_To_unversioned_ImageFsUsage(in *ImageFsUsage, out *unversioned.ImageFsUsage, s conversion.Scope) error {
+	out.CapacityBytes = in.CapacityBytes
+	out.UsedBytesBefore = in.UsedBytesBefore
+	out.UsedBytesAfter = in.UsedBytesAfter
+	out.ImageBytesBefore = in.ImageBytesBefore
+	out.ImageBytesAfter = in.ImageBytesAfter
+	return nil
+}
+
+// Convert_v1_ImageFsUsage_To_unversioned_ImageFsUsage is an autogenerated conversion function.
+func Convert_v1_ImageFsUsage_To_unversioned_ImageFsUsage(in *ImageFsUsage, out *unversioned.ImageFsUsage, s conversion.Scope) error {
+	return autoConvert_v1_ImageFsUsage_To_unversioned_ImageFsUsage(in, out, s)
+}
+
+func autoConvert_unversioned_ImageFsUsage_To_v1_ImageFsUsage(in *unversioned.ImageFsUsage, out *ImageFsUsage, s conversion.Scope) error {
+	out.CapacityBytes = in.CapacityBytes
+	out.UsedBytesBefore = in.UsedBytesBefore
+	out.UsedBytesAfter = in.UsedBytesAfter
+	out.ImageBytesBefore = in.ImageBytesBefore
+	out.ImageBytesAfter = in.ImageBytesAfter
+	return nil
+}
+
+// Convert_unversioned_ImageFsUsage_To_v1_ImageFsUsage is an autogenerated conversion function.
+func Convert_unversioned_ImageFsUsage_To_v1_ImageFsUsage(in *unversioned.ImageFsUsage, out *ImageFsUsage, s conversion.Scope) error {
+	return autoConvert_unversioned_ImageFsUsage_To_v1_ImageFsUsage(in, out, s)
+}
+
 func autoConvert_v1_ImageJob_To_unversioned_ImageJob(in *ImageJob, out *unversioned.ImageJob, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_ImageJobStatus_To_unversioned_ImageJobStatus(&in.Status, &out.Status, s); err != nil {
@@ -196,8 +260,10 @@ func autoConvert_v1_ImageJobStatus_To_unversioned_ImageJobStatus(in *ImageJobSta
 	out.Succeeded = in.Succeeded
 	out.Desired = in.Desired
 	out.Skipped = in.Skipped
+	out.ReclaimedBytes = in.ReclaimedBytes
 	out.Phase = unversioned.JobPhase(in.Phase)
 	out.DeleteAfter = (*metav1.Time)(unsafe.Pointer(in.DeleteAfter))
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -211,8 +277,10 @@ func autoConvert_unversioned_ImageJobStatus_To_v1_ImageJobStatus(in *unversioned
 	out.Succeeded = in.Succeeded
 	out.Desired = in.Desired
 	out.Skipped = in.Skipped
+	out.ReclaimedBytes = in.ReclaimedBytes
 	out.Phase = JobPhase(in.Phase)
 	out.DeleteAfter = (*metav1.Time)(unsafe.Pointer(in.DeleteAfter))
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -277,6 +345,7 @@ func Convert_unversioned_ImageListList_To_v1_ImageListList(in *unversioned.Image
 
 func autoConvert_v1_ImageListSpec_To_unversioned_ImageListSpec(in *ImageListSpec, out *unversioned.ImageListSpec, s conversion.Scope) error {
 	out.Images = *(*[]string)(unsafe.Pointer(&in.Images))
+	out.DryRun = in.DryRun
 	return nil
 }
 
@@ -287,6 +356,7 @@ func Convert_v1_ImageListSpec_To_unversioned_ImageListSpec(in *ImageListSpec, ou
 
 func autoConvert_unversioned_ImageListSpec_To_v1_ImageListSpec(in *unversioned.ImageListSpec, out *ImageListSpec, s conversion.Scope) error {
 	out.Images = *(*[]string)(unsafe.Pointer(&in.Images))
+	out.DryRun = in.DryRun
 	return nil
 }
 
@@ -300,6 +370,10 @@ func autoConvert_v1_ImageListStatus_To_unversioned_ImageListStatus(in *ImageList
 	out.Success = in.Success
 	out.Failed = in.Failed
 	out.Skipped = in.Skipped
+	out.ReclaimedBytes = in.ReclaimedBytes
+	out.Nodes = *(*[]unversioned.NodeResult)(unsafe.Pointer(&in.Nodes))
+	out.NodesOmitted = in.NodesOmitted
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -313,6 +387,10 @@ func autoConvert_unversioned_ImageListStatus_To_v1_ImageListStatus(in *unversion
 	out.Success = in.Success
 	out.Failed = in.Failed
 	out.Skipped = in.Skipped
+	out.ReclaimedBytes = in.ReclaimedBytes
+	out.Nodes = *(*[]NodeResult)(unsafe.Pointer(&in.Nodes))
+	out.NodesOmitted = in.NodesOmitted
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -320,3 +398,79 @@ func autoConvert_unversioned_ImageListStatus_To_v1_ImageListStatus(in *unversion
 func Convert_unversioned_ImageListStatus_To_v1_ImageListStatus(in *unversioned.ImageListStatus, out *ImageListStatus, s conversion.Scope) error {
 	return autoConvert_unversioned_ImageListStatus_To_v1_ImageListStatus(in, out, s)
 }
+
+func autoConvert_v1_ImageResult_To_unversioned_ImageResult(in *ImageResult, out *unversioned.ImageResult, s conversion.Scope) error {
+	out.Image = in.Image
+	out.Result = unversioned.ImageResultType(in.Result)
+	out.Message = in.Message
+	out.ExcludedBy = *(*[]string)(unsafe.Pointer(&in.ExcludedBy))
+	out.MatchedBy = in.MatchedBy
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1_ImageResult_To_unversioned_ImageResult is an autogenerated conversion function.
+func Convert_v1_ImageResult_To_unversioned_ImageResult(in *ImageResult, out *unversioned.ImageResult, s conversion.Scope) error {
+	return autoConvert_v1_ImageResult_To_unversioned_ImageResult(in, out, s)
+}
+
+func autoConvert_unversioned_ImageResult_To_v1_ImageResult(in *unversioned.ImageResult, out *ImageResult, s conversion.Scope) error {
+	out.Image = in.Image
+	out.Result = ImageResultType(in.Result)
+	out.Message = in.Message
+	out.ExcludedBy = *(*[]string)(unsafe.Pointer(&in.ExcludedBy))
+	out.MatchedBy = in.MatchedBy
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_unversioned_ImageResult_To_v1_ImageResult is an autogenerated conversion function.
+func Convert_unversioned_ImageResult_To_v1_ImageResult(in *unversioned.ImageResult, out *ImageResult, s conversion.Scope) error {
+	return autoConvert_unversioned_ImageResult_To_v1_ImageResult(in, out, s)
+}
+
+func autoConvert_v1_NodeResult_To_unversioned_NodeResult(in *NodeResult, out *unversioned.NodeResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Removed = in.Removed
+	out.WouldRemove = in.WouldRemove
+	out.Skipped = in.Skipped
+	out.Running = in.Running
+	out.Excluded = in.Excluded
+	out.NotPresent = in.NotPresent
+	out.Errors = in.Errors
+	out.ReclaimedBytes = in.ReclaimedBytes
+	out.WouldReclaimBytes = in.WouldReclaimBytes
+	out.Exclusions = *(*map[string]int64)(unsafe.Pointer(&in.Exclusions))
+	out.ImageFs = (*unversioned.ImageFsUsage)(unsafe.Pointer(in.ImageFs))
+	out.Images = *(*[]unversioned.ImageResult)(unsafe.Pointer(&in.Images))
+	out.ImagesTruncated = in.ImagesTruncated
+	return nil
+}
+
+// Convert_v1_NodeResult_To_unversioned_NodeResult is an autogenerated conversion function.
+func Convert_v1_NodeResult_To_unversioned_NodeResult(in *NodeResult, out *unversioned.NodeResult, s conversion.Scope) error {
+	return autoConvert_v1_NodeResult_To_unversioned_NodeResult(in, out, s)
+}
+
+func autoConvert_unversioned_NodeResult_To_v1_NodeResult(in *unversioned.NodeResult, out *NodeResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Removed = in.Removed
+	out.WouldRemove = in.WouldRemove
+	out.Skipped = in.Skipped
+	out.Running = in.Running
+	out.Excluded = in.Excluded
+	out.NotPresent = in.NotPresent
+	out.Errors = in.Errors
+	out.ReclaimedBytes = in.ReclaimedBytes
+	out.WouldReclaimBytes = in.WouldReclaimBytes
+	out.Exclusions = *(*map[string]int64)(unsafe.Pointer(&in.Exclusions))
+	out.ImageFs = (*ImageFsUsage)(unsafe.Pointer(in.ImageFs))
+	out.Images = *(*[]ImageResult)(unsafe.Pointer(&in.Images))
+	out.ImagesTruncated = in.ImagesTruncated
+	return nil
+}
+
+// Convert_unversioned_NodeResult_To_v1_NodeResult is an autogenerated conversion function.
+func Convert_unversioned_NodeResult_To_v1_NodeResult(in *unversioned.NodeResult, out *NodeResult, s conversion.Scope) error {
+	return autoConvert_unversioned_NodeResult_To_v1_NodeResult(in, out, s)
+}
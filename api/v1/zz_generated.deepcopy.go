@@ -21,6 +21,7 @@ limitations under the License.
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -37,6 +38,14 @@ func (in *Image) DeepCopyInto(out *Image) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastUsed != nil {
+		in, out := &in.LastUsed, &out.LastUsed
+		*out = (*in).DeepCopy()
+	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
@@ -49,6 +58,119 @@ func (in *Image) DeepCopy() *Image {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageExclusion) DeepCopyInto(out *ImageExclusion) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageExclusion.
+func (in *ImageExclusion) DeepCopy() *ImageExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageExclusion) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageExclusionList) DeepCopyInto(out *ImageExclusionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ImageExclusion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageExclusionList.
+func (in *ImageExclusionList) DeepCopy() *ImageExclusionList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageExclusionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageExclusionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageExclusionSpec) DeepCopyInto(out *ImageExclusionSpec) {
+	*out = *in
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageExclusionSpec.
+func (in *ImageExclusionSpec) DeepCopy() *ImageExclusionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageExclusionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageExclusionStatus) DeepCopyInto(out *ImageExclusionStatus) {
+	*out = *in
+	if in.LastRun != nil {
+		in, out := &in.LastRun, &out.LastRun
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageExclusionStatus.
+func (in *ImageExclusionStatus) DeepCopy() *ImageExclusionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageExclusionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFsUsage) DeepCopyInto(out *ImageFsUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFsUsage.
+func (in *ImageFsUsage) DeepCopy() *ImageFsUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFsUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageJob) DeepCopyInto(out *ImageJob) {
 	*out = *in
@@ -114,6 +236,13 @@ func (in *ImageJobStatus) DeepCopyInto(out *ImageJobStatus) {
 		in, out := &in.DeleteAfter, &out.DeleteAfter
 		*out = (*in).DeepCopy()
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageJobStatus.
@@ -212,6 +341,20 @@ func (in *ImageListStatus) DeepCopyInto(out *ImageListStatus) {
 		in, out := &in.Timestamp, &out.Timestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageListStatus.
@@ -223,3 +366,57 @@ func (in *ImageListStatus) DeepCopy() *ImageListStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageResult) DeepCopyInto(out *ImageResult) {
+	*out = *in
+	if in.ExcludedBy != nil {
+		in, out := &in.ExcludedBy, &out.ExcludedBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageResult.
+func (in *ImageResult) DeepCopy() *ImageResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeResult) DeepCopyInto(out *NodeResult) {
+	*out = *in
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ImageFs != nil {
+		in, out := &in.ImageFs, &out.ImageFs
+		*out = new(ImageFsUsage)
+		**out = **in
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ImageResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeResult.
+func (in *NodeResult) DeepCopy() *NodeResult {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeResult)
+	in.DeepCopyInto(out)
+	return out
+}
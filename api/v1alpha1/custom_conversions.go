@@ -13,6 +13,12 @@ func Convert_v1alpha1_ManagerConfig_To_unversioned_ManagerConfig(in *ManagerConf
 //nolint:revive
 func manualConvert_v1alpha1_Runtime_To_unversioned_RuntimeSpec(in *Runtime, out *unversioned.RuntimeSpec, _ conversion.Scope) error {
 	out.Name = unversioned.Runtime(string(*in))
+	if out.Name == unversioned.RuntimeNotProvided {
+		// left to the defaults, as in an EraserConfig resource that does not
+		// set the runtime
+		out.Address = ""
+		return nil
+	}
 
 	rs, err := unversioned.ConvertRuntimeToRuntimeSpec(out.Name)
 	if err != nil {
@@ -43,3 +49,8 @@ func manualConvert_unversioned_RuntimeSpec_To_v1alpha1_Runtime(in *unversioned.R
 func Convert_unversioned_RuntimeSpec_To_v1alpha1_Runtime(in *unversioned.RuntimeSpec, out *Runtime, s conversion.Scope) error {
 	return manualConvert_unversioned_RuntimeSpec_To_v1alpha1_Runtime(in, out, s)
 }
+
+//nolint:revive
+func Convert_unversioned_ScheduleConfig_To_v1alpha1_ScheduleConfig(in *unversioned.ScheduleConfig, out *ScheduleConfig, s conversion.Scope) error {
+	return autoConvert_unversioned_ScheduleConfig_To_v1alpha1_ScheduleConfig(in, out, s)
+}
@@ -0,0 +1,46 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/eraser-dev/eraser/api/v1alpha3"
+)
+
+func TestEraserConfigConversion(t *testing.T) {
+	src := &EraserConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "eraser-config", Generation: 2},
+		Components: Components{
+			Eraser: ContainerConfig{Image: RepoTag{Repo: "remover", Tag: "v1"}},
+		},
+		Status: EraserConfigStatus{ObservedGeneration: 2, Accepted: true},
+	}
+
+	hub := &v1alpha3.EraserConfig{}
+	if err := src.ConvertTo(hub); err != nil {
+		t.Fatal(err)
+	}
+
+	if hub.Name != src.Name || hub.Generation != src.Generation {
+		t.Errorf("metadata was not converted: %+v", hub.ObjectMeta)
+	}
+	if hub.Components.Remover.Image.Repo != "remover" {
+		t.Errorf("eraser was not converted to remover: %+v", hub.Components.Remover)
+	}
+	if !hub.Status.Accepted || hub.Status.ObservedGeneration != 2 {
+		t.Errorf("status was not converted: %+v", hub.Status)
+	}
+
+	dst := &EraserConfig{}
+	if err := dst.ConvertFrom(hub); err != nil {
+		t.Fatal(err)
+	}
+
+	if dst.Components.Eraser.Image != src.Components.Eraser.Image {
+		t.Errorf("expected %+v, got %+v", src.Components.Eraser.Image, dst.Components.Eraser.Image)
+	}
+	if dst.Status != src.Status {
+		t.Errorf("expected %+v, got %+v", src.Status, dst.Status)
+	}
+}
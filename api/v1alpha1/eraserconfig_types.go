@@ -29,6 +29,8 @@ import (
 )
 
 type (
+	// Duration is a time.Duration written as a string, such as "24h".
+	// +kubebuilder:validation:Type=string
 	Duration time.Duration
 	Runtime  string
 )
@@ -89,7 +91,11 @@ type ContainerConfig struct {
 	Request ResourceRequirements `json:"request,omitempty"`
 	Limit   ResourceRequirements `json:"limit,omitempty"`
 	Config  *string              `json:"config,omitempty"`
-	Volumes []corev1.Volume      `json:"volumes,omitempty"`
+	// Volumes to mount into the component's container. They are not
+	// validated by the EraserConfig CRD.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
 }
 
 type ManagerConfig struct {
@@ -115,6 +121,8 @@ type ProfileConfig struct {
 }
 
 type ImageJobConfig struct {
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=number
 	SuccessRatio float64               `json:"successRatio,omitempty"`
 	Cleanup      ImageJobCleanupConfig `json:"cleanup,omitempty"`
 }
@@ -145,17 +153,43 @@ type Components struct {
 	Eraser    ContainerConfig         `json:"eraser,omitempty"`
 }
 
+// EraserConfigStatus reports whether the manager accepted an EraserConfig.
+type EraserConfigStatus struct {
+	// The generation of the EraserConfig the status refers to
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Whether the configuration is valid and in use by the manager
+	Accepted bool `json:"accepted"`
+	// Why the configuration was not accepted
+	Message string `json:"message,omitempty"`
+}
+
 //+kubebuilder:object:root=true
+//+kubebuilder:resource:scope="Cluster"
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Accepted",type=boolean,JSONPath=".status.accepted"
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
 
-// EraserConfig is the Schema for the eraserconfigs API.
+// EraserConfig is the Schema for the eraserconfigs API. It is read from the
+// manager's configuration file, or served as a cluster-scoped resource.
 type EraserConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Manager           ManagerConfig      `json:"manager"`
+	Components        Components         `json:"components"`
+	Status            EraserConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// EraserConfigList contains a list of EraserConfig.
+type EraserConfigList struct {
 	metav1.TypeMeta `json:",inline"`
-	Manager         ManagerConfig `json:"manager"`
-	Components      Components    `json:"components"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EraserConfig `json:"items"`
 }
 
 func init() {
-	SchemeBuilder.Register(&EraserConfig{})
+	SchemeBuilder.Register(&EraserConfig{}, &EraserConfigList{})
 }
 
 // In future versions of EraserConfig (for example, v1alpha2), the
@@ -171,7 +205,7 @@ func Convert_v1alpha1_Components_To_unversioned_Components(in *Components, out *
 	if err := Convert_v1alpha1_OptionalContainerConfig_To_unversioned_OptionalContainerConfig(&in.Scanner, &out.Scanner, s); err != nil {
 		return err
 	}
-	return Convert_v1alpha1_ContainerConfig_To_unversioned_ContainerConfig(&in.Eraser, &out.Remover, s)
+	return Convert_v1alpha1_ContainerConfig_To_unversioned_ContainerConfig(&in.Eraser, &out.Remover.ContainerConfig, s)
 }
 
 func Convert_unversioned_Components_To_v1alpha1_Components(in *unversioned.Components, out *Components, s conversion.Scope) error { //nolint:revive
@@ -181,5 +215,5 @@ func Convert_unversioned_Components_To_v1alpha1_Components(in *unversioned.Compo
 	if err := Convert_unversioned_OptionalContainerConfig_To_v1alpha1_OptionalContainerConfig(&in.Scanner, &out.Scanner, s); err != nil {
 		return err
 	}
-	return Convert_unversioned_ContainerConfig_To_v1alpha1_ContainerConfig(&in.Remover, &out.Eraser, s)
+	return Convert_unversioned_ContainerConfig_To_v1alpha1_ContainerConfig(&in.Remover.ContainerConfig, &out.Eraser, s)
 }
@@ -57,6 +57,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EraserConfigList)(nil), (*unversioned.EraserConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EraserConfigList_To_unversioned_EraserConfigList(a.(*EraserConfigList), b.(*unversioned.EraserConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.EraserConfigList)(nil), (*EraserConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_EraserConfigList_To_v1alpha1_EraserConfigList(a.(*unversioned.EraserConfigList), b.(*EraserConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EraserConfigStatus)(nil), (*unversioned.EraserConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EraserConfigStatus_To_unversioned_EraserConfigStatus(a.(*EraserConfigStatus), b.(*unversioned.EraserConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.EraserConfigStatus)(nil), (*EraserConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_EraserConfigStatus_To_v1alpha1_EraserConfigStatus(a.(*unversioned.EraserConfigStatus), b.(*EraserConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Image)(nil), (*unversioned.Image)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Image_To_unversioned_Image(a.(*Image), b.(*unversioned.Image), scope)
 	}); err != nil {
@@ -67,6 +87,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImageFsUsage)(nil), (*unversioned.ImageFsUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageFsUsage_To_unversioned_ImageFsUsage(a.(*ImageFsUsage), b.(*unversioned.ImageFsUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.ImageFsUsage)(nil), (*ImageFsUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_ImageFsUsage_To_v1alpha1_ImageFsUsage(a.(*unversioned.ImageFsUsage), b.(*ImageFsUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ImageJob)(nil), (*unversioned.ImageJob)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ImageJob_To_unversioned_ImageJob(a.(*ImageJob), b.(*unversioned.ImageJob), scope)
 	}); err != nil {
@@ -157,6 +187,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImageResult)(nil), (*unversioned.ImageResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageResult_To_unversioned_ImageResult(a.(*ImageResult), b.(*unversioned.ImageResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.ImageResult)(nil), (*ImageResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_ImageResult_To_v1alpha1_ImageResult(a.(*unversioned.ImageResult), b.(*ImageResult), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeFilterConfig)(nil), (*unversioned.NodeFilterConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NodeFilterConfig_To_unversioned_NodeFilterConfig(a.(*NodeFilterConfig), b.(*unversioned.NodeFilterConfig), scope)
 	}); err != nil {
@@ -167,6 +207,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeResult)(nil), (*unversioned.NodeResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeResult_To_unversioned_NodeResult(a.(*NodeResult), b.(*unversioned.NodeResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.NodeResult)(nil), (*NodeResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_NodeResult_To_v1alpha1_NodeResult(a.(*unversioned.NodeResult), b.(*NodeResult), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OptionalContainerConfig)(nil), (*unversioned.OptionalContainerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_OptionalContainerConfig_To_unversioned_OptionalContainerConfig(a.(*OptionalContainerConfig), b.(*unversioned.OptionalContainerConfig), scope)
 	}); err != nil {
@@ -212,11 +262,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*unversioned.ScheduleConfig)(nil), (*ScheduleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_unversioned_ScheduleConfig_To_v1alpha1_ScheduleConfig(a.(*unversioned.ScheduleConfig), b.(*ScheduleConfig), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddConversionFunc((*unversioned.Components)(nil), (*Components)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_unversioned_Components_To_v1alpha1_Components(a.(*unversioned.Components), b.(*Components), scope)
 	}); err != nil {
@@ -232,6 +277,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*unversioned.ScheduleConfig)(nil), (*ScheduleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_ScheduleConfig_To_v1alpha1_ScheduleConfig(a.(*unversioned.ScheduleConfig), b.(*ScheduleConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*Components)(nil), (*unversioned.Components)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Components_To_unversioned_Components(a.(*Components), b.(*unversioned.Components), scope)
 	}); err != nil {
@@ -313,12 +363,16 @@ func Convert_unversioned_ContainerConfig_To_v1alpha1_ContainerConfig(in *unversi
 }
 
 func autoConvert_v1alpha1_EraserConfig_To_unversioned_EraserConfig(in *EraserConfig, out *unversioned.EraserConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ManagerConfig_To_unversioned_ManagerConfig(&in.Manager, &out.Manager, s); err != nil {
 		return err
 	}
 	if err := Convert_v1alpha1_Components_To_unversioned_Components(&in.Components, &out.Components, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_EraserConfigStatus_To_unversioned_EraserConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -328,12 +382,16 @@ func Convert_v1alpha1_EraserConfig_To_unversioned_EraserConfig(in *EraserConfig,
 }
 
 func autoConvert_unversioned_EraserConfig_To_v1alpha1_EraserConfig(in *unversioned.EraserConfig, out *EraserConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_unversioned_ManagerConfig_To_v1alpha1_ManagerConfig(&in.Manager, &out.Manager, s); err != nil {
 		return err
 	}
 	if err := Convert_unversioned_Components_To_v1alpha1_Components(&in.Components, &out.Components, s); err != nil {
 		return err
 	}
+	if err := Convert_unversioned_EraserConfigStatus_To_v1alpha1_EraserConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -342,10 +400,79 @@ func Convert_unversioned_EraserConfig_To_v1alpha1_EraserConfig(in *unversioned.E
 	return autoConvert_unversioned_EraserConfig_To_v1alpha1_EraserConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_EraserConfigList_To_unversioned_EraserConfigList(in *EraserConfigList, out *unversioned.EraserConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]unversioned.EraserConfig, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_EraserConfig_To_unversioned_EraserConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_EraserConfigList_To_unversioned_EraserConfigList is an autogenerated conversion function.
+func Convert_v1alpha1_EraserConfigList_To_unversioned_EraserConfigList(in *EraserConfigList, out *unversioned.EraserConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EraserConfigList_To_unversioned_EraserConfigList(in, out, s)
+}
+
+func autoConvert_unversioned_EraserConfigList_To_v1alpha1_EraserConfigList(in *unversioned.EraserConfigList, out *EraserConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EraserConfig, len(*in))
+		for i := range *in {
+			if err := Convert_unversioned_EraserConfig_To_v1alpha1_EraserConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_unversioned_EraserConfigList_To_v1alpha1_EraserConfigList is an autogenerated conversion function.
+func Convert_unversioned_EraserConfigList_To_v1alpha1_EraserConfigList(in *unversioned.EraserConfigList, out *EraserConfigList, s conversion.Scope) error {
+	return autoConvert_unversioned_EraserConfigList_To_v1alpha1_EraserConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha1_EraserConfigStatus_To_unversioned_EraserConfigStatus(in *EraserConfigStatus, out *unversioned.EraserConfigStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Accepted = in.Accepted
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_EraserConfigStatus_To_unversioned_EraserConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha1_EraserConfigStatus_To_unversioned_EraserConfigStatus(in *EraserConfigStatus, out *unversioned.EraserConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EraserConfigStatus_To_unversioned_EraserConfigStatus(in, out, s)
+}
+
+func autoConvert_unversioned_EraserConfigStatus_To_v1alpha1_EraserConfigStatus(in *unversioned.EraserConfigStatus, out *EraserConfigStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Accepted = in.Accepted
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_unversioned_EraserConfigStatus_To_v1alpha1_EraserConfigStatus is an autogenerated conversion function.
+func Convert_unversioned_EraserConfigStatus_To_v1alpha1_EraserConfigStatus(in *unversioned.EraserConfigStatus, out *EraserConfigStatus, s conversion.Scope) error {
+	return autoConvert_unversioned_EraserConfigStatus_To_v1alpha1_EraserConfigStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_Image_To_unversioned_Image(in *Image, out *unversioned.Image, s conversion.Scope) error {
 	out.ImageID = in.ImageID
 	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
 	out.Digests = *(*[]string)(unsafe.Pointer(&in.Digests))
+	out.LastUsed = (*metav1.Time)(unsafe.Pointer(in.LastUsed))
+	out.Created = (*metav1.Time)(unsafe.Pointer(in.Created))
+	out.Size = in.Size
 	return nil
 }
 
@@ -358,6 +485,9 @@ func autoConvert_unversioned_Image_To_v1alpha1_Image(in *unversioned.Image, out
 	out.ImageID = in.ImageID
 	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
 	out.Digests = *(*[]string)(unsafe.Pointer(&in.Digests))
+	out.LastUsed = (*metav1.Time)(unsafe.Pointer(in.LastUsed))
+	out.Created = (*metav1.Time)(unsafe.Pointer(in.Created))
+	out.Size = in.Size
 	return nil
 }
 
@@ -366,6 +496,34 @@ func Convert_unversioned_Image_To_v1alpha1_Image(in *unversioned.Image, out *Ima
 	return autoConvert_unversioned_Image_To_v1alpha1_Image(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImageFsUsage_To_unversioned_ImageFsUsage(in *ImageFsUsage, out *unversioned.ImageFsUsage, s conversion.Scope) error {
+	out.CapacityBytes = in.CapacityBytes
+	out.UsedBytesBefore = in.UsedBytesBefore
+	out.UsedBytesAfter = in.UsedBytesAfter
+	out.ImageBytesBefore = in.ImageBytesBefore
+	out.ImageBytesAfter = in.ImageBytesAfter
+	return nil
+}
+
+// Convert_v1alpha1_ImageFsUsage_To_unversioned_ImageFsUsage is an autogenerated conversion function.
+func Convert_v1alpha1_ImageFsUsage_To_unversioned_ImageFsUsage(in *ImageFsUsage, out *unversioned.ImageFsUsage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageFsUsage_To_unversioned_ImageFsUsage(in, out, s)
+}
+
+func autoConvert_unversioned_ImageFsUsage_To_v1alpha1_ImageFsUsage(in *unversioned.ImageFsUsage, out *ImageFsUsage, s conversion.Scope) error {
+	out.CapacityBytes = in.CapacityBytes
+	out.UsedBytesBefore = in.UsedBytesBefore
+	out.UsedBytesAfter = in.UsedBytesAfter
+	out.ImageBytesBefore = in.ImageBytesBefore
+	out.ImageBytesAfter = in.ImageBytesAfter
+	return nil
+}
+
+// Convert_unversioned_ImageFsUsage_To_v1alpha1_ImageFsUsage is an autogenerated conversion function.
+func Convert_unversioned_ImageFsUsage_To_v1alpha1_ImageFsUsage(in *unversioned.ImageFsUsage, out *ImageFsUsage, s conversion.Scope) error {
+	return autoConvert_unversioned_ImageFsUsage_To_v1alpha1_ImageFsUsage(in, out, s)
+}
+
 func autoConvert_v1alpha1_ImageJob_To_unversioned_ImageJob(in *ImageJob, out *unversioned.ImageJob, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ImageJobStatus_To_unversioned_ImageJobStatus(&in.Status, &out.Status, s); err != nil {
@@ -467,8 +625,10 @@ func autoConvert_v1alpha1_ImageJobStatus_To_unversioned_ImageJobStatus(in *Image
 	out.Succeeded = in.Succeeded
 	out.Desired = in.Desired
 	out.Skipped = in.Skipped
+	out.ReclaimedBytes = in.ReclaimedBytes
 	out.Phase = unversioned.JobPhase(in.Phase)
 	out.DeleteAfter = (*metav1.Time)(unsafe.Pointer(in.DeleteAfter))
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -482,8 +642,10 @@ func autoConvert_unversioned_ImageJobStatus_To_v1alpha1_ImageJobStatus(in *unver
 	out.Succeeded = in.Succeeded
 	out.Desired = in.Desired
 	out.Skipped = in.Skipped
+	out.ReclaimedBytes = in.ReclaimedBytes
 	out.Phase = JobPhase(in.Phase)
 	out.DeleteAfter = (*metav1.Time)(unsafe.Pointer(in.DeleteAfter))
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -548,6 +710,7 @@ func Convert_unversioned_ImageListList_To_v1alpha1_ImageListList(in *unversioned
 
 func autoConvert_v1alpha1_ImageListSpec_To_unversioned_ImageListSpec(in *ImageListSpec, out *unversioned.ImageListSpec, s conversion.Scope) error {
 	out.Images = *(*[]string)(unsafe.Pointer(&in.Images))
+	out.DryRun = in.DryRun
 	return nil
 }
 
@@ -558,6 +721,7 @@ func Convert_v1alpha1_ImageListSpec_To_unversioned_ImageListSpec(in *ImageListSp
 
 func autoConvert_unversioned_ImageListSpec_To_v1alpha1_ImageListSpec(in *unversioned.ImageListSpec, out *ImageListSpec, s conversion.Scope) error {
 	out.Images = *(*[]string)(unsafe.Pointer(&in.Images))
+	out.DryRun = in.DryRun
 	return nil
 }
 
@@ -571,6 +735,10 @@ func autoConvert_v1alpha1_ImageListStatus_To_unversioned_ImageListStatus(in *Ima
 	out.Success = in.Success
 	out.Failed = in.Failed
 	out.Skipped = in.Skipped
+	out.ReclaimedBytes = in.ReclaimedBytes
+	out.Nodes = *(*[]unversioned.NodeResult)(unsafe.Pointer(&in.Nodes))
+	out.NodesOmitted = in.NodesOmitted
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -584,6 +752,10 @@ func autoConvert_unversioned_ImageListStatus_To_v1alpha1_ImageListStatus(in *unv
 	out.Success = in.Success
 	out.Failed = in.Failed
 	out.Skipped = in.Skipped
+	out.ReclaimedBytes = in.ReclaimedBytes
+	out.Nodes = *(*[]NodeResult)(unsafe.Pointer(&in.Nodes))
+	out.NodesOmitted = in.NodesOmitted
+	out.Conditions = *(*[]metav1.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -592,6 +764,36 @@ func Convert_unversioned_ImageListStatus_To_v1alpha1_ImageListStatus(in *unversi
 	return autoConvert_unversioned_ImageListStatus_To_v1alpha1_ImageListStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImageResult_To_unversioned_ImageResult(in *ImageResult, out *unversioned.ImageResult, s conversion.Scope) error {
+	out.Image = in.Image
+	out.Result = unversioned.ImageResultType(in.Result)
+	out.Message = in.Message
+	out.ExcludedBy = *(*[]string)(unsafe.Pointer(&in.ExcludedBy))
+	out.MatchedBy = in.MatchedBy
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_v1alpha1_ImageResult_To_unversioned_ImageResult is an autogenerated conversion function.
+func Convert_v1alpha1_ImageResult_To_unversioned_ImageResult(in *ImageResult, out *unversioned.ImageResult, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageResult_To_unversioned_ImageResult(in, out, s)
+}
+
+func autoConvert_unversioned_ImageResult_To_v1alpha1_ImageResult(in *unversioned.ImageResult, out *ImageResult, s conversion.Scope) error {
+	out.Image = in.Image
+	out.Result = ImageResultType(in.Result)
+	out.Message = in.Message
+	out.ExcludedBy = *(*[]string)(unsafe.Pointer(&in.ExcludedBy))
+	out.MatchedBy = in.MatchedBy
+	out.Size = in.Size
+	return nil
+}
+
+// Convert_unversioned_ImageResult_To_v1alpha1_ImageResult is an autogenerated conversion function.
+func Convert_unversioned_ImageResult_To_v1alpha1_ImageResult(in *unversioned.ImageResult, out *ImageResult, s conversion.Scope) error {
+	return autoConvert_unversioned_ImageResult_To_v1alpha1_ImageResult(in, out, s)
+}
+
 func autoConvert_v1alpha1_ManagerConfig_To_unversioned_ManagerConfig(in *ManagerConfig, out *unversioned.ManagerConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_Runtime_To_unversioned_RuntimeSpec(&in.Runtime, &out.Runtime, s); err != nil {
 		return err
@@ -636,6 +838,8 @@ func autoConvert_unversioned_ManagerConfig_To_v1alpha1_ManagerConfig(in *unversi
 	}
 	out.PriorityClassName = in.PriorityClassName
 	// WARNING: in.AdditionalPodLabels requires manual conversion: does not exist in peer-type
+	// WARNING: in.DryRun requires manual conversion: does not exist in peer-type
+	// WARNING: in.CollectorPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -661,6 +865,52 @@ func Convert_unversioned_NodeFilterConfig_To_v1alpha1_NodeFilterConfig(in *unver
 	return autoConvert_unversioned_NodeFilterConfig_To_v1alpha1_NodeFilterConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_NodeResult_To_unversioned_NodeResult(in *NodeResult, out *unversioned.NodeResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Removed = in.Removed
+	out.WouldRemove = in.WouldRemove
+	out.Skipped = in.Skipped
+	out.Running = in.Running
+	out.Excluded = in.Excluded
+	out.NotPresent = in.NotPresent
+	out.Errors = in.Errors
+	out.ReclaimedBytes = in.ReclaimedBytes
+	out.WouldReclaimBytes = in.WouldReclaimBytes
+	out.Exclusions = *(*map[string]int64)(unsafe.Pointer(&in.Exclusions))
+	out.ImageFs = (*unversioned.ImageFsUsage)(unsafe.Pointer(in.ImageFs))
+	out.Images = *(*[]unversioned.ImageResult)(unsafe.Pointer(&in.Images))
+	out.ImagesTruncated = in.ImagesTruncated
+	return nil
+}
+
+// Convert_v1alpha1_NodeResult_To_unversioned_NodeResult is an autogenerated conversion function.
+func Convert_v1alpha1_NodeResult_To_unversioned_NodeResult(in *NodeResult, out *unversioned.NodeResult, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeResult_To_unversioned_NodeResult(in, out, s)
+}
+
+func autoConvert_unversioned_NodeResult_To_v1alpha1_NodeResult(in *unversioned.NodeResult, out *NodeResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Removed = in.Removed
+	out.WouldRemove = in.WouldRemove
+	out.Skipped = in.Skipped
+	out.Running = in.Running
+	out.Excluded = in.Excluded
+	out.NotPresent = in.NotPresent
+	out.Errors = in.Errors
+	out.ReclaimedBytes = in.ReclaimedBytes
+	out.WouldReclaimBytes = in.WouldReclaimBytes
+	out.Exclusions = *(*map[string]int64)(unsafe.Pointer(&in.Exclusions))
+	out.ImageFs = (*ImageFsUsage)(unsafe.Pointer(in.ImageFs))
+	out.Images = *(*[]ImageResult)(unsafe.Pointer(&in.Images))
+	out.ImagesTruncated = in.ImagesTruncated
+	return nil
+}
+
+// Convert_unversioned_NodeResult_To_v1alpha1_NodeResult is an autogenerated conversion function.
+func Convert_unversioned_NodeResult_To_v1alpha1_NodeResult(in *unversioned.NodeResult, out *NodeResult, s conversion.Scope) error {
+	return autoConvert_unversioned_NodeResult_To_v1alpha1_NodeResult(in, out, s)
+}
+
 func autoConvert_v1alpha1_OptionalContainerConfig_To_unversioned_OptionalContainerConfig(in *OptionalContainerConfig, out *unversioned.OptionalContainerConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	if err := Convert_v1alpha1_ContainerConfig_To_unversioned_ContainerConfig(&in.ContainerConfig, &out.ContainerConfig, s); err != nil {
@@ -767,10 +1017,9 @@ func Convert_v1alpha1_ScheduleConfig_To_unversioned_ScheduleConfig(in *ScheduleC
 func autoConvert_unversioned_ScheduleConfig_To_v1alpha1_ScheduleConfig(in *unversioned.ScheduleConfig, out *ScheduleConfig, s conversion.Scope) error {
 	out.RepeatInterval = Duration(in.RepeatInterval)
 	out.BeginImmediately = in.BeginImmediately
+	// WARNING: in.Cron requires manual conversion: does not exist in peer-type
+	// WARNING: in.TimeZone requires manual conversion: does not exist in peer-type
+	// WARNING: in.BlackoutWindows requires manual conversion: does not exist in peer-type
+	// WARNING: in.DiskPressure requires manual conversion: does not exist in peer-type
 	return nil
 }
-
-// Convert_unversioned_ScheduleConfig_To_v1alpha1_ScheduleConfig is an autogenerated conversion function.
-func Convert_unversioned_ScheduleConfig_To_v1alpha1_ScheduleConfig(in *unversioned.ScheduleConfig, out *ScheduleConfig, s conversion.Scope) error {
-	return autoConvert_unversioned_ScheduleConfig_To_v1alpha1_ScheduleConfig(in, out, s)
-}
@@ -22,6 +22,7 @@ package v1alpha1
 
 import (
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -77,8 +78,10 @@ func (in *ContainerConfig) DeepCopy() *ContainerConfig {
 func (in *EraserConfig) DeepCopyInto(out *EraserConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Manager.DeepCopyInto(&out.Manager)
 	in.Components.DeepCopyInto(&out.Components)
+	out.Status = in.Status
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfig.
@@ -99,6 +102,53 @@ func (in *EraserConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EraserConfigList) DeepCopyInto(out *EraserConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EraserConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfigList.
+func (in *EraserConfigList) DeepCopy() *EraserConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(EraserConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EraserConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EraserConfigStatus) DeepCopyInto(out *EraserConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfigStatus.
+func (in *EraserConfigStatus) DeepCopy() *EraserConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EraserConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
@@ -112,6 +162,14 @@ func (in *Image) DeepCopyInto(out *Image) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastUsed != nil {
+		in, out := &in.LastUsed, &out.LastUsed
+		*out = (*in).DeepCopy()
+	}
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
@@ -124,6 +182,21 @@ func (in *Image) DeepCopy() *Image {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageFsUsage) DeepCopyInto(out *ImageFsUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageFsUsage.
+func (in *ImageFsUsage) DeepCopy() *ImageFsUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageFsUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageJob) DeepCopyInto(out *ImageJob) {
 	*out = *in
@@ -220,6 +293,13 @@ func (in *ImageJobStatus) DeepCopyInto(out *ImageJobStatus) {
 		in, out := &in.DeleteAfter, &out.DeleteAfter
 		*out = (*in).DeepCopy()
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageJobStatus.
@@ -318,6 +398,20 @@ func (in *ImageListStatus) DeepCopyInto(out *ImageListStatus) {
 		in, out := &in.Timestamp, &out.Timestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageListStatus.
@@ -330,6 +424,26 @@ func (in *ImageListStatus) DeepCopy() *ImageListStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageResult) DeepCopyInto(out *ImageResult) {
+	*out = *in
+	if in.ExcludedBy != nil {
+		in, out := &in.ExcludedBy, &out.ExcludedBy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageResult.
+func (in *ImageResult) DeepCopy() *ImageResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagerConfig) DeepCopyInto(out *ManagerConfig) {
 	*out = *in
@@ -374,6 +488,40 @@ func (in *NodeFilterConfig) DeepCopy() *NodeFilterConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeResult) DeepCopyInto(out *NodeResult) {
+	*out = *in
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ImageFs != nil {
+		in, out := &in.ImageFs, &out.ImageFs
+		*out = new(ImageFsUsage)
+		**out = **in
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ImageResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeResult.
+func (in *NodeResult) DeepCopy() *NodeResult {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OptionalContainerConfig) DeepCopyInto(out *OptionalContainerConfig) {
 	*out = *in
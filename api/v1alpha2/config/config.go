@@ -19,13 +19,13 @@ vulnerabilities:
   types:
     - os
     - library
-securityChecks: # need to be documented; determined by trivy, not us
-  - vuln
-severities:
-  - CRITICAL
-  - HIGH
-  - MEDIUM
-  - LOW
+  securityChecks: # need to be documented; determined by trivy, not us
+    - vuln
+  severities:
+    - CRITICAL
+    - HIGH
+    - MEDIUM
+    - LOW
 `
 
 const (
@@ -34,6 +34,10 @@ const (
 )
 
 func Default() *v1alpha2.EraserConfig {
+	// the configuration is unmarshaled into the defaults, which must not
+	// overwrite the default scanner configuration itself
+	scannerConfig := defaultScannerConfig
+
 	return &v1alpha2.EraserConfig{
 		Manager: v1alpha2.ManagerConfig{
 			Runtime:      "containerd",
@@ -96,7 +100,7 @@ func Default() *v1alpha2.EraserConfig {
 						Mem: resource.MustParse("2Gi"),
 						CPU: resource.MustParse("1500m"),
 					},
-					Config: &defaultScannerConfig,
+					Config: &scannerConfig,
 				},
 			},
 			Remover: v1alpha2.ContainerConfig{
@@ -13,6 +13,12 @@ func Convert_v1alpha2_ManagerConfig_To_unversioned_ManagerConfig(in *ManagerConf
 //nolint:revive
 func manualConvert_v1alpha2_Runtime_To_unversioned_RuntimeSpec(in *Runtime, out *unversioned.RuntimeSpec, _ conversion.Scope) error {
 	out.Name = unversioned.Runtime(string(*in))
+	if out.Name == unversioned.RuntimeNotProvided {
+		// left to the defaults, as in an EraserConfig resource that does not
+		// set the runtime
+		out.Address = ""
+		return nil
+	}
 
 	rs, err := unversioned.ConvertRuntimeToRuntimeSpec(out.Name)
 	if err != nil {
@@ -43,3 +49,27 @@ func manualConvert_unversioned_RuntimeSpec_To_v1alpha2_Runtime(in *unversioned.R
 func Convert_unversioned_RuntimeSpec_To_v1alpha2_Runtime(in *unversioned.RuntimeSpec, out *Runtime, s conversion.Scope) error {
 	return manualConvert_unversioned_RuntimeSpec_To_v1alpha2_Runtime(in, out, s)
 }
+
+//nolint:revive
+func Convert_unversioned_ScheduleConfig_To_v1alpha2_ScheduleConfig(in *unversioned.ScheduleConfig, out *ScheduleConfig, s conversion.Scope) error {
+	return autoConvert_unversioned_ScheduleConfig_To_v1alpha2_ScheduleConfig(in, out, s)
+}
+
+// Convert_v1alpha2_Components_To_unversioned_Components converts the remover,
+// which has no settings of its own in v1alpha2.
+//
+//nolint:revive
+func Convert_v1alpha2_Components_To_unversioned_Components(in *Components, out *unversioned.Components, s conversion.Scope) error {
+	if err := autoConvert_v1alpha2_Components_To_unversioned_Components(in, out, s); err != nil {
+		return err
+	}
+	return Convert_v1alpha2_ContainerConfig_To_unversioned_ContainerConfig(&in.Remover, &out.Remover.ContainerConfig, s)
+}
+
+//nolint:revive
+func Convert_unversioned_Components_To_v1alpha2_Components(in *unversioned.Components, out *Components, s conversion.Scope) error {
+	if err := autoConvert_unversioned_Components_To_v1alpha2_Components(in, out, s); err != nil {
+		return err
+	}
+	return Convert_unversioned_ContainerConfig_To_v1alpha2_ContainerConfig(&in.Remover.ContainerConfig, &out.Remover, s)
+}
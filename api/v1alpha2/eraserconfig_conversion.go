@@ -0,0 +1,40 @@
+package v1alpha2
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	"github.com/eraser-dev/eraser/api/v1alpha3"
+)
+
+// ConvertTo converts this EraserConfig to the hub version, v1alpha3.
+func (src *EraserConfig) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1alpha3.EraserConfig)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", dstRaw)
+	}
+
+	var unv unversioned.EraserConfig
+	if err := Convert_v1alpha2_EraserConfig_To_unversioned_EraserConfig(src, &unv, nil); err != nil {
+		return err
+	}
+
+	return v1alpha3.Convert_unversioned_EraserConfig_To_v1alpha3_EraserConfig(&unv, dst, nil)
+}
+
+// ConvertFrom converts from the hub version, v1alpha3, to this EraserConfig.
+func (dst *EraserConfig) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1alpha3.EraserConfig)
+	if !ok {
+		return fmt.Errorf("unexpected hub type %T", srcRaw)
+	}
+
+	var unv unversioned.EraserConfig
+	if err := v1alpha3.Convert_v1alpha3_EraserConfig_To_unversioned_EraserConfig(src, &unv, nil); err != nil {
+		return err
+	}
+
+	return Convert_unversioned_EraserConfig_To_v1alpha2_EraserConfig(&unv, dst, nil)
+}
@@ -27,6 +27,8 @@ import (
 )
 
 type (
+	// Duration is a time.Duration written as a string, such as "24h".
+	// +kubebuilder:validation:Type=string
 	Duration time.Duration
 	Runtime  string
 )
@@ -87,7 +89,11 @@ type ContainerConfig struct {
 	Request ResourceRequirements `json:"request,omitempty"`
 	Limit   ResourceRequirements `json:"limit,omitempty"`
 	Config  *string              `json:"config,omitempty"`
-	Volumes []corev1.Volume      `json:"volumes,omitempty"`
+	// Volumes to mount into the component's container. They are not
+	// validated by the EraserConfig CRD.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
 }
 
 type ManagerConfig struct {
@@ -113,6 +119,8 @@ type ProfileConfig struct {
 }
 
 type ImageJobConfig struct {
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=number
 	SuccessRatio float64               `json:"successRatio,omitempty"`
 	Cleanup      ImageJobCleanupConfig `json:"cleanup,omitempty"`
 }
@@ -143,15 +151,41 @@ type Components struct {
 	Remover   ContainerConfig         `json:"remover,omitempty"`
 }
 
+// EraserConfigStatus reports whether the manager accepted an EraserConfig.
+type EraserConfigStatus struct {
+	// The generation of the EraserConfig the status refers to
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Whether the configuration is valid and in use by the manager
+	Accepted bool `json:"accepted"`
+	// Why the configuration was not accepted
+	Message string `json:"message,omitempty"`
+}
+
 //+kubebuilder:object:root=true
+//+kubebuilder:resource:scope="Cluster"
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Accepted",type=boolean,JSONPath=".status.accepted"
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
 
-// EraserConfig is the Schema for the eraserconfigs API.
+// EraserConfig is the Schema for the eraserconfigs API. It is read from the
+// manager's configuration file, or served as a cluster-scoped resource.
 type EraserConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Manager           ManagerConfig      `json:"manager"`
+	Components        Components         `json:"components"`
+	Status            EraserConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// EraserConfigList contains a list of EraserConfig.
+type EraserConfigList struct {
 	metav1.TypeMeta `json:",inline"`
-	Manager         ManagerConfig `json:"manager"`
-	Components      Components    `json:"components"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EraserConfig `json:"items"`
 }
 
 func init() {
-	SchemeBuilder.Register(&EraserConfig{})
+	SchemeBuilder.Register(&EraserConfig{}, &EraserConfigList{})
 }
@@ -36,16 +36,6 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
-	if err := s.AddGeneratedConversionFunc((*Components)(nil), (*unversioned.Components)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha2_Components_To_unversioned_Components(a.(*Components), b.(*unversioned.Components), scope)
-	}); err != nil {
-		return err
-	}
-	if err := s.AddGeneratedConversionFunc((*unversioned.Components)(nil), (*Components)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_unversioned_Components_To_v1alpha2_Components(a.(*unversioned.Components), b.(*Components), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ContainerConfig)(nil), (*unversioned.ContainerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ContainerConfig_To_unversioned_ContainerConfig(a.(*ContainerConfig), b.(*unversioned.ContainerConfig), scope)
 	}); err != nil {
@@ -66,6 +56,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EraserConfigList)(nil), (*unversioned.EraserConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EraserConfigList_To_unversioned_EraserConfigList(a.(*EraserConfigList), b.(*unversioned.EraserConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.EraserConfigList)(nil), (*EraserConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_EraserConfigList_To_v1alpha2_EraserConfigList(a.(*unversioned.EraserConfigList), b.(*EraserConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EraserConfigStatus)(nil), (*unversioned.EraserConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_EraserConfigStatus_To_unversioned_EraserConfigStatus(a.(*EraserConfigStatus), b.(*unversioned.EraserConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.EraserConfigStatus)(nil), (*EraserConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_EraserConfigStatus_To_v1alpha2_EraserConfigStatus(a.(*unversioned.EraserConfigStatus), b.(*EraserConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ImageJobCleanupConfig)(nil), (*unversioned.ImageJobCleanupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ImageJobCleanupConfig_To_unversioned_ImageJobCleanupConfig(a.(*ImageJobCleanupConfig), b.(*unversioned.ImageJobCleanupConfig), scope)
 	}); err != nil {
@@ -141,8 +151,8 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*unversioned.ScheduleConfig)(nil), (*ScheduleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_unversioned_ScheduleConfig_To_v1alpha2_ScheduleConfig(a.(*unversioned.ScheduleConfig), b.(*ScheduleConfig), scope)
+	if err := s.AddConversionFunc((*unversioned.Components)(nil), (*Components)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_Components_To_v1alpha2_Components(a.(*unversioned.Components), b.(*Components), scope)
 	}); err != nil {
 		return err
 	}
@@ -156,6 +166,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*unversioned.ScheduleConfig)(nil), (*ScheduleConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_ScheduleConfig_To_v1alpha2_ScheduleConfig(a.(*unversioned.ScheduleConfig), b.(*ScheduleConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*Components)(nil), (*unversioned.Components)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_Components_To_unversioned_Components(a.(*Components), b.(*unversioned.Components), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*ManagerConfig)(nil), (*unversioned.ManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ManagerConfig_To_unversioned_ManagerConfig(a.(*ManagerConfig), b.(*unversioned.ManagerConfig), scope)
 	}); err != nil {
@@ -176,17 +196,10 @@ func autoConvert_v1alpha2_Components_To_unversioned_Components(in *Components, o
 	if err := Convert_v1alpha2_OptionalContainerConfig_To_unversioned_OptionalContainerConfig(&in.Scanner, &out.Scanner, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha2_ContainerConfig_To_unversioned_ContainerConfig(&in.Remover, &out.Remover, s); err != nil {
-		return err
-	}
+	// WARNING: in.Remover requires manual conversion: inconvertible types (github.com/eraser-dev/eraser/api/v1alpha2.ContainerConfig vs github.com/eraser-dev/eraser/api/unversioned.RemoverConfig)
 	return nil
 }
 
-// Convert_v1alpha2_Components_To_unversioned_Components is an autogenerated conversion function.
-func Convert_v1alpha2_Components_To_unversioned_Components(in *Components, out *unversioned.Components, s conversion.Scope) error {
-	return autoConvert_v1alpha2_Components_To_unversioned_Components(in, out, s)
-}
-
 func autoConvert_unversioned_Components_To_v1alpha2_Components(in *unversioned.Components, out *Components, s conversion.Scope) error {
 	if err := Convert_unversioned_OptionalContainerConfig_To_v1alpha2_OptionalContainerConfig(&in.Collector, &out.Collector, s); err != nil {
 		return err
@@ -194,17 +207,10 @@ func autoConvert_unversioned_Components_To_v1alpha2_Components(in *unversioned.C
 	if err := Convert_unversioned_OptionalContainerConfig_To_v1alpha2_OptionalContainerConfig(&in.Scanner, &out.Scanner, s); err != nil {
 		return err
 	}
-	if err := Convert_unversioned_ContainerConfig_To_v1alpha2_ContainerConfig(&in.Remover, &out.Remover, s); err != nil {
-		return err
-	}
+	// WARNING: in.Remover requires manual conversion: inconvertible types (github.com/eraser-dev/eraser/api/unversioned.RemoverConfig vs github.com/eraser-dev/eraser/api/v1alpha2.ContainerConfig)
 	return nil
 }
 
-// Convert_unversioned_Components_To_v1alpha2_Components is an autogenerated conversion function.
-func Convert_unversioned_Components_To_v1alpha2_Components(in *unversioned.Components, out *Components, s conversion.Scope) error {
-	return autoConvert_unversioned_Components_To_v1alpha2_Components(in, out, s)
-}
-
 func autoConvert_v1alpha2_ContainerConfig_To_unversioned_ContainerConfig(in *ContainerConfig, out *unversioned.ContainerConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha2_RepoTag_To_unversioned_RepoTag(&in.Image, &out.Image, s); err != nil {
 		return err
@@ -246,12 +252,16 @@ func Convert_unversioned_ContainerConfig_To_v1alpha2_ContainerConfig(in *unversi
 }
 
 func autoConvert_v1alpha2_EraserConfig_To_unversioned_EraserConfig(in *EraserConfig, out *unversioned.EraserConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha2_ManagerConfig_To_unversioned_ManagerConfig(&in.Manager, &out.Manager, s); err != nil {
 		return err
 	}
 	if err := Convert_v1alpha2_Components_To_unversioned_Components(&in.Components, &out.Components, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha2_EraserConfigStatus_To_unversioned_EraserConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -261,12 +271,16 @@ func Convert_v1alpha2_EraserConfig_To_unversioned_EraserConfig(in *EraserConfig,
 }
 
 func autoConvert_unversioned_EraserConfig_To_v1alpha2_EraserConfig(in *unversioned.EraserConfig, out *EraserConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_unversioned_ManagerConfig_To_v1alpha2_ManagerConfig(&in.Manager, &out.Manager, s); err != nil {
 		return err
 	}
 	if err := Convert_unversioned_Components_To_v1alpha2_Components(&in.Components, &out.Components, s); err != nil {
 		return err
 	}
+	if err := Convert_unversioned_EraserConfigStatus_To_v1alpha2_EraserConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -275,6 +289,72 @@ func Convert_unversioned_EraserConfig_To_v1alpha2_EraserConfig(in *unversioned.E
 	return autoConvert_unversioned_EraserConfig_To_v1alpha2_EraserConfig(in, out, s)
 }
 
+func autoConvert_v1alpha2_EraserConfigList_To_unversioned_EraserConfigList(in *EraserConfigList, out *unversioned.EraserConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]unversioned.EraserConfig, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha2_EraserConfig_To_unversioned_EraserConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha2_EraserConfigList_To_unversioned_EraserConfigList is an autogenerated conversion function.
+func Convert_v1alpha2_EraserConfigList_To_unversioned_EraserConfigList(in *EraserConfigList, out *unversioned.EraserConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EraserConfigList_To_unversioned_EraserConfigList(in, out, s)
+}
+
+func autoConvert_unversioned_EraserConfigList_To_v1alpha2_EraserConfigList(in *unversioned.EraserConfigList, out *EraserConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EraserConfig, len(*in))
+		for i := range *in {
+			if err := Convert_unversioned_EraserConfig_To_v1alpha2_EraserConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_unversioned_EraserConfigList_To_v1alpha2_EraserConfigList is an autogenerated conversion function.
+func Convert_unversioned_EraserConfigList_To_v1alpha2_EraserConfigList(in *unversioned.EraserConfigList, out *EraserConfigList, s conversion.Scope) error {
+	return autoConvert_unversioned_EraserConfigList_To_v1alpha2_EraserConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha2_EraserConfigStatus_To_unversioned_EraserConfigStatus(in *EraserConfigStatus, out *unversioned.EraserConfigStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Accepted = in.Accepted
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha2_EraserConfigStatus_To_unversioned_EraserConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha2_EraserConfigStatus_To_unversioned_EraserConfigStatus(in *EraserConfigStatus, out *unversioned.EraserConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha2_EraserConfigStatus_To_unversioned_EraserConfigStatus(in, out, s)
+}
+
+func autoConvert_unversioned_EraserConfigStatus_To_v1alpha2_EraserConfigStatus(in *unversioned.EraserConfigStatus, out *EraserConfigStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Accepted = in.Accepted
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_unversioned_EraserConfigStatus_To_v1alpha2_EraserConfigStatus is an autogenerated conversion function.
+func Convert_unversioned_EraserConfigStatus_To_v1alpha2_EraserConfigStatus(in *unversioned.EraserConfigStatus, out *EraserConfigStatus, s conversion.Scope) error {
+	return autoConvert_unversioned_EraserConfigStatus_To_v1alpha2_EraserConfigStatus(in, out, s)
+}
+
 func autoConvert_v1alpha2_ImageJobCleanupConfig_To_unversioned_ImageJobCleanupConfig(in *ImageJobCleanupConfig, out *unversioned.ImageJobCleanupConfig, s conversion.Scope) error {
 	out.DelayOnSuccess = unversioned.Duration(in.DelayOnSuccess)
 	out.DelayOnFailure = unversioned.Duration(in.DelayOnFailure)
@@ -367,6 +447,8 @@ func autoConvert_unversioned_ManagerConfig_To_v1alpha2_ManagerConfig(in *unversi
 	}
 	out.PriorityClassName = in.PriorityClassName
 	// WARNING: in.AdditionalPodLabels requires manual conversion: does not exist in peer-type
+	// WARNING: in.DryRun requires manual conversion: does not exist in peer-type
+	// WARNING: in.CollectorPolicy requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -498,10 +580,9 @@ func Convert_v1alpha2_ScheduleConfig_To_unversioned_ScheduleConfig(in *ScheduleC
 func autoConvert_unversioned_ScheduleConfig_To_v1alpha2_ScheduleConfig(in *unversioned.ScheduleConfig, out *ScheduleConfig, s conversion.Scope) error {
 	out.RepeatInterval = Duration(in.RepeatInterval)
 	out.BeginImmediately = in.BeginImmediately
+	// WARNING: in.Cron requires manual conversion: does not exist in peer-type
+	// WARNING: in.TimeZone requires manual conversion: does not exist in peer-type
+	// WARNING: in.BlackoutWindows requires manual conversion: does not exist in peer-type
+	// WARNING: in.DiskPressure requires manual conversion: does not exist in peer-type
 	return nil
 }
-
-// Convert_unversioned_ScheduleConfig_To_v1alpha2_ScheduleConfig is an autogenerated conversion function.
-func Convert_unversioned_ScheduleConfig_To_v1alpha2_ScheduleConfig(in *unversioned.ScheduleConfig, out *ScheduleConfig, s conversion.Scope) error {
-	return autoConvert_unversioned_ScheduleConfig_To_v1alpha2_ScheduleConfig(in, out, s)
-}
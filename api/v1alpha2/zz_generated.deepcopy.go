@@ -77,8 +77,10 @@ func (in *ContainerConfig) DeepCopy() *ContainerConfig {
 func (in *EraserConfig) DeepCopyInto(out *EraserConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Manager.DeepCopyInto(&out.Manager)
 	in.Components.DeepCopyInto(&out.Components)
+	out.Status = in.Status
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfig.
@@ -99,6 +101,53 @@ func (in *EraserConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EraserConfigList) DeepCopyInto(out *EraserConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EraserConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfigList.
+func (in *EraserConfigList) DeepCopy() *EraserConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(EraserConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EraserConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EraserConfigStatus) DeepCopyInto(out *EraserConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfigStatus.
+func (in *EraserConfigStatus) DeepCopy() *EraserConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EraserConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageJobCleanupConfig) DeepCopyInto(out *ImageJobCleanupConfig) {
 	*out = *in
@@ -14,26 +14,35 @@ cacheDir: /var/lib/trivy
 dbRepo: ghcr.io/aquasecurity/trivy-db
 deleteFailedImages: true
 deleteEOLImages: true
+concurrency: 1
+cacheResults: true
 vulnerabilities:
   ignoreUnfixed: false
   types:
     - os
     - library
-securityChecks: # need to be documented; determined by trivy, not us
-  - vuln
-severities:
-  - CRITICAL
-  - HIGH
-  - MEDIUM
-  - LOW
+  securityChecks: # need to be documented; determined by trivy, not us
+    - vuln
+  severities:
+    - CRITICAL
+    - HIGH
+    - MEDIUM
+    - LOW
 `
 
 const (
 	noDelay = v1alpha3.Duration(0)
 	oneDay  = v1alpha3.Duration(time.Hour * 24)
+	oneHour = v1alpha3.Duration(time.Hour)
+
+	fiveMinutes = v1alpha3.Duration(5 * time.Minute)
 )
 
 func Default() *v1alpha3.EraserConfig {
+	// the configuration is unmarshaled into the defaults, which must not
+	// overwrite the default scanner configuration itself
+	scannerConfig := defaultScannerConfig
+
 	return &v1alpha3.EraserConfig{
 		Manager: v1alpha3.ManagerConfig{
 			Runtime: v1alpha3.RuntimeSpec{
@@ -45,6 +54,9 @@ func Default() *v1alpha3.EraserConfig {
 			Scheduling: v1alpha3.ScheduleConfig{
 				RepeatInterval:   v1alpha3.Duration(oneDay),
 				BeginImmediately: true,
+				DiskPressure: v1alpha3.DiskPressureTrigger{
+					Cooldown: oneHour,
+				},
 			},
 			Profile: v1alpha3.ProfileConfig{
 				Enabled: false,
@@ -100,23 +112,28 @@ func Default() *v1alpha3.EraserConfig {
 						Mem: resource.MustParse("2Gi"),
 						CPU: resource.MustParse("1500m"),
 					},
-					Config: &defaultScannerConfig,
+					Config: &scannerConfig,
 				},
 			},
-			Remover: v1alpha3.ContainerConfig{
-				Image: v1alpha3.RepoTag{
-					Repo: repo("remover"),
-					Tag:  version.BuildVersion,
-				},
-				Request: v1alpha3.ResourceRequirements{
-					Mem: resource.MustParse("25Mi"),
-					CPU: resource.MustParse("7m"),
-				},
-				Limit: v1alpha3.ResourceRequirements{
-					Mem: resource.MustParse("30Mi"),
-					CPU: resource.Quantity{},
+			Remover: v1alpha3.RemoverConfig{
+				ContainerConfig: v1alpha3.ContainerConfig{
+					Image: v1alpha3.RepoTag{
+						Repo: repo("remover"),
+						Tag:  version.BuildVersion,
+					},
+					Request: v1alpha3.ResourceRequirements{
+						Mem: resource.MustParse("25Mi"),
+						CPU: resource.MustParse("7m"),
+					},
+					Limit: v1alpha3.ResourceRequirements{
+						Mem: resource.MustParse("30Mi"),
+						CPU: resource.Quantity{},
+					},
+					Config: nil,
 				},
-				Config: nil,
+				Concurrency:      4,
+				DeletesPerSecond: 0,
+				Timeout:          fiveMinutes,
 			},
 		},
 	}
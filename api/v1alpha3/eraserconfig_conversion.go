@@ -0,0 +1,5 @@
+package v1alpha3
+
+// Hub marks v1alpha3 as the version EraserConfig resources are stored in and
+// converted through.
+func (*EraserConfig) Hub() {}
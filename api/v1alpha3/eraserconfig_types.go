@@ -28,6 +28,8 @@ import (
 )
 
 type (
+	// Duration is a time.Duration written as a string, such as "24h".
+	// +kubebuilder:validation:Type=string
 	Duration time.Duration
 	Runtime  string
 
@@ -154,7 +156,25 @@ type ContainerConfig struct {
 	Request ResourceRequirements `json:"request,omitempty"`
 	Limit   ResourceRequirements `json:"limit,omitempty"`
 	Config  *string              `json:"config,omitempty"`
-	Volumes []corev1.Volume      `json:"volumes,omitempty"`
+	// Volumes to mount into the component's container. They are not
+	// validated by the EraserConfig CRD.
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+}
+
+// RemoverConfig configures the remover, which removes the images from the
+// nodes.
+type RemoverConfig struct {
+	ContainerConfig `json:",inline"`
+	// Number of images removed at the same time on a node.
+	Concurrency int `json:"concurrency,omitempty"`
+	// Maximum number of images removed per second on a node. Zero removes
+	// images as fast as the workers allow.
+	DeletesPerSecond int `json:"deletesPerSecond,omitempty"`
+	// Time the remover has to remove the images of a node before it gives up
+	// on the rest.
+	Timeout Duration `json:"timeout,omitempty"`
 }
 
 type ManagerConfig struct {
@@ -168,11 +188,34 @@ type ManagerConfig struct {
 	NodeFilter          NodeFilterConfig  `json:"nodeFilter,omitempty"`
 	PriorityClassName   string            `json:"priorityClassName,omitempty"`
 	AdditionalPodLabels map[string]string `json:"additionalPodLabels,omitempty"`
+	DryRun              bool              `json:"dryRun,omitempty"`
+	CollectorPolicy     CollectorPolicy   `json:"collectorPolicy,omitempty"`
 }
 
 type ScheduleConfig struct {
-	RepeatInterval   Duration `json:"repeatInterval,omitempty"`
-	BeginImmediately bool     `json:"beginImmediately,omitempty"`
+	RepeatInterval   Duration            `json:"repeatInterval,omitempty"`
+	BeginImmediately bool                `json:"beginImmediately,omitempty"`
+	Cron             string              `json:"cron,omitempty"`
+	TimeZone         string              `json:"timeZone,omitempty"`
+	BlackoutWindows  []BlackoutWindow    `json:"blackoutWindows,omitempty"`
+	DiskPressure     DiskPressureTrigger `json:"diskPressure,omitempty"`
+}
+
+type BlackoutWindow struct {
+	Start    string   `json:"start"`
+	Duration Duration `json:"duration"`
+}
+
+// DiskPressureTrigger starts a collector ImageJob on the nodes whose image
+// filesystem is under pressure, without waiting for the next scheduled run.
+type DiskPressureTrigger struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// A node is not triggered again for this long, so that a node whose
+	// condition flaps does not start a job each time.
+	Cooldown Duration `json:"cooldown,omitempty"`
+	// Nodes whose eraser.sh/imagefs-usage annotation is at or above this
+	// percentage are under pressure too. Zero ignores the annotation.
+	ImageFsUsagePercent int `json:"imageFsUsagePercent,omitempty"`
 }
 
 type ProfileConfig struct {
@@ -181,6 +224,8 @@ type ProfileConfig struct {
 }
 
 type ImageJobConfig struct {
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=number
 	SuccessRatio float64               `json:"successRatio,omitempty"`
 	Cleanup      ImageJobCleanupConfig `json:"cleanup,omitempty"`
 }
@@ -190,6 +235,32 @@ type ImageJobCleanupConfig struct {
 	DelayOnFailure Duration `json:"delayOnFailure,omitempty"`
 }
 
+// CollectorPolicy narrows down the images the collector hands on for removal.
+type CollectorPolicy struct {
+	// Only images that no container has used for at least this long are
+	// removed. Zero disables the policy.
+	UnusedFor Duration `json:"unusedFor,omitempty"`
+	// Keep the most recently created images of each repository that no
+	// container runs, this many of them. Zero disables the policy.
+	KeepLast int `json:"keepLast,omitempty"`
+	// Only remove images while the image filesystem is under pressure.
+	DiskUsage DiskUsagePolicy `json:"diskUsage,omitempty"`
+}
+
+// DiskUsagePolicy gates the removal of images on the usage of the image
+// filesystem of each node.
+type DiskUsagePolicy struct {
+	// Images are only removed from nodes whose image filesystem is used
+	// above this percentage. Zero disables the policy.
+	HighThresholdPercent int `json:"highThresholdPercent,omitempty"`
+	// Removal stops once the usage is below this percentage. Zero removes
+	// all the images.
+	LowThresholdPercent int `json:"lowThresholdPercent,omitempty"`
+	// A directory on the image filesystem of the nodes. Defaults to the root
+	// directory of the runtime, such as /var/lib/containerd.
+	ImageFsPath string `json:"imageFsPath,omitempty"`
+}
+
 type NodeFilterConfig struct {
 	Type      string   `json:"type,omitempty"`
 	Selectors []string `json:"selectors,omitempty"`
@@ -208,18 +279,45 @@ type RepoTag struct {
 type Components struct {
 	Collector OptionalContainerConfig `json:"collector,omitempty"`
 	Scanner   OptionalContainerConfig `json:"scanner,omitempty"`
-	Remover   ContainerConfig         `json:"remover,omitempty"`
+	Remover   RemoverConfig           `json:"remover,omitempty"`
 }
 
-//+kubebuilder:object:root=true
+// EraserConfigStatus reports whether the manager accepted an EraserConfig.
+type EraserConfigStatus struct {
+	// The generation of the EraserConfig the status refers to
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Whether the configuration is valid and in use by the manager
+	Accepted bool `json:"accepted"`
+	// Why the configuration was not accepted
+	Message string `json:"message,omitempty"`
+}
 
-// EraserConfig is the Schema for the eraserconfigs API.
+//+kubebuilder:object:root=true
+//+kubebuilder:resource:scope="Cluster"
+//+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+//+kubebuilder:printcolumn:name="Accepted",type=boolean,JSONPath=".status.accepted"
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+
+// EraserConfig is the Schema for the eraserconfigs API. It is read from the
+// manager's configuration file, or served as a cluster-scoped resource.
 type EraserConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Manager           ManagerConfig      `json:"manager"`
+	Components        Components         `json:"components"`
+	Status            EraserConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// EraserConfigList contains a list of EraserConfig.
+type EraserConfigList struct {
 	metav1.TypeMeta `json:",inline"`
-	Manager         ManagerConfig `json:"manager"`
-	Components      Components    `json:"components"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EraserConfig `json:"items"`
 }
 
 func init() {
-	SchemeBuilder.Register(&EraserConfig{})
+	SchemeBuilder.Register(&EraserConfig{}, &EraserConfigList{})
 }
@@ -36,6 +36,26 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BlackoutWindow)(nil), (*unversioned.BlackoutWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_BlackoutWindow_To_unversioned_BlackoutWindow(a.(*BlackoutWindow), b.(*unversioned.BlackoutWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.BlackoutWindow)(nil), (*BlackoutWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_BlackoutWindow_To_v1alpha3_BlackoutWindow(a.(*unversioned.BlackoutWindow), b.(*BlackoutWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CollectorPolicy)(nil), (*unversioned.CollectorPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_CollectorPolicy_To_unversioned_CollectorPolicy(a.(*CollectorPolicy), b.(*unversioned.CollectorPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.CollectorPolicy)(nil), (*CollectorPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_CollectorPolicy_To_v1alpha3_CollectorPolicy(a.(*unversioned.CollectorPolicy), b.(*CollectorPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Components)(nil), (*unversioned.Components)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_Components_To_unversioned_Components(a.(*Components), b.(*unversioned.Components), scope)
 	}); err != nil {
@@ -56,6 +76,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DiskPressureTrigger)(nil), (*unversioned.DiskPressureTrigger)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_DiskPressureTrigger_To_unversioned_DiskPressureTrigger(a.(*DiskPressureTrigger), b.(*unversioned.DiskPressureTrigger), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.DiskPressureTrigger)(nil), (*DiskPressureTrigger)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_DiskPressureTrigger_To_v1alpha3_DiskPressureTrigger(a.(*unversioned.DiskPressureTrigger), b.(*DiskPressureTrigger), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DiskUsagePolicy)(nil), (*unversioned.DiskUsagePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_DiskUsagePolicy_To_unversioned_DiskUsagePolicy(a.(*DiskUsagePolicy), b.(*unversioned.DiskUsagePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.DiskUsagePolicy)(nil), (*DiskUsagePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_DiskUsagePolicy_To_v1alpha3_DiskUsagePolicy(a.(*unversioned.DiskUsagePolicy), b.(*DiskUsagePolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EraserConfig)(nil), (*unversioned.EraserConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_EraserConfig_To_unversioned_EraserConfig(a.(*EraserConfig), b.(*unversioned.EraserConfig), scope)
 	}); err != nil {
@@ -66,6 +106,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EraserConfigList)(nil), (*unversioned.EraserConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_EraserConfigList_To_unversioned_EraserConfigList(a.(*EraserConfigList), b.(*unversioned.EraserConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.EraserConfigList)(nil), (*EraserConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_EraserConfigList_To_v1alpha3_EraserConfigList(a.(*unversioned.EraserConfigList), b.(*EraserConfigList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EraserConfigStatus)(nil), (*unversioned.EraserConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_EraserConfigStatus_To_unversioned_EraserConfigStatus(a.(*EraserConfigStatus), b.(*unversioned.EraserConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.EraserConfigStatus)(nil), (*EraserConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_EraserConfigStatus_To_v1alpha3_EraserConfigStatus(a.(*unversioned.EraserConfigStatus), b.(*EraserConfigStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ImageJobCleanupConfig)(nil), (*unversioned.ImageJobCleanupConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ImageJobCleanupConfig_To_unversioned_ImageJobCleanupConfig(a.(*ImageJobCleanupConfig), b.(*unversioned.ImageJobCleanupConfig), scope)
 	}); err != nil {
@@ -126,6 +186,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RemoverConfig)(nil), (*unversioned.RemoverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_RemoverConfig_To_unversioned_RemoverConfig(a.(*RemoverConfig), b.(*unversioned.RemoverConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*unversioned.RemoverConfig)(nil), (*RemoverConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_unversioned_RemoverConfig_To_v1alpha3_RemoverConfig(a.(*unversioned.RemoverConfig), b.(*RemoverConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RepoTag)(nil), (*unversioned.RepoTag)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_RepoTag_To_unversioned_RepoTag(a.(*RepoTag), b.(*unversioned.RepoTag), scope)
 	}); err != nil {
@@ -169,6 +239,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha3_BlackoutWindow_To_unversioned_BlackoutWindow(in *BlackoutWindow, out *unversioned.BlackoutWindow, s conversion.Scope) error {
+	out.Start = in.Start
+	out.Duration = unversioned.Duration(in.Duration)
+	return nil
+}
+
+// Convert_v1alpha3_BlackoutWindow_To_unversioned_BlackoutWindow is an autogenerated conversion function.
+func Convert_v1alpha3_BlackoutWindow_To_unversioned_BlackoutWindow(in *BlackoutWindow, out *unversioned.BlackoutWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha3_BlackoutWindow_To_unversioned_BlackoutWindow(in, out, s)
+}
+
+func autoConvert_unversioned_BlackoutWindow_To_v1alpha3_BlackoutWindow(in *unversioned.BlackoutWindow, out *BlackoutWindow, s conversion.Scope) error {
+	out.Start = in.Start
+	out.Duration = Duration(in.Duration)
+	return nil
+}
+
+// Convert_unversioned_BlackoutWindow_To_v1alpha3_BlackoutWindow is an autogenerated conversion function.
+func Convert_unversioned_BlackoutWindow_To_v1alpha3_BlackoutWindow(in *unversioned.BlackoutWindow, out *BlackoutWindow, s conversion.Scope) error {
+	return autoConvert_unversioned_BlackoutWindow_To_v1alpha3_BlackoutWindow(in, out, s)
+}
+
+func autoConvert_v1alpha3_CollectorPolicy_To_unversioned_CollectorPolicy(in *CollectorPolicy, out *unversioned.CollectorPolicy, s conversion.Scope) error {
+	out.UnusedFor = unversioned.Duration(in.UnusedFor)
+	out.KeepLast = in.KeepLast
+	if err := Convert_v1alpha3_DiskUsagePolicy_To_unversioned_DiskUsagePolicy(&in.DiskUsage, &out.DiskUsage, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha3_CollectorPolicy_To_unversioned_CollectorPolicy is an autogenerated conversion function.
+func Convert_v1alpha3_CollectorPolicy_To_unversioned_CollectorPolicy(in *CollectorPolicy, out *unversioned.CollectorPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha3_CollectorPolicy_To_unversioned_CollectorPolicy(in, out, s)
+}
+
+func autoConvert_unversioned_CollectorPolicy_To_v1alpha3_CollectorPolicy(in *unversioned.CollectorPolicy, out *CollectorPolicy, s conversion.Scope) error {
+	out.UnusedFor = Duration(in.UnusedFor)
+	out.KeepLast = in.KeepLast
+	if err := Convert_unversioned_DiskUsagePolicy_To_v1alpha3_DiskUsagePolicy(&in.DiskUsage, &out.DiskUsage, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_unversioned_CollectorPolicy_To_v1alpha3_CollectorPolicy is an autogenerated conversion function.
+func Convert_unversioned_CollectorPolicy_To_v1alpha3_CollectorPolicy(in *unversioned.CollectorPolicy, out *CollectorPolicy, s conversion.Scope) error {
+	return autoConvert_unversioned_CollectorPolicy_To_v1alpha3_CollectorPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha3_Components_To_unversioned_Components(in *Components, out *unversioned.Components, s conversion.Scope) error {
 	if err := Convert_v1alpha3_OptionalContainerConfig_To_unversioned_OptionalContainerConfig(&in.Collector, &out.Collector, s); err != nil {
 		return err
@@ -176,7 +296,7 @@ func autoConvert_v1alpha3_Components_To_unversioned_Components(in *Components, o
 	if err := Convert_v1alpha3_OptionalContainerConfig_To_unversioned_OptionalContainerConfig(&in.Scanner, &out.Scanner, s); err != nil {
 		return err
 	}
-	if err := Convert_v1alpha3_ContainerConfig_To_unversioned_ContainerConfig(&in.Remover, &out.Remover, s); err != nil {
+	if err := Convert_v1alpha3_RemoverConfig_To_unversioned_RemoverConfig(&in.Remover, &out.Remover, s); err != nil {
 		return err
 	}
 	return nil
@@ -194,7 +314,7 @@ func autoConvert_unversioned_Components_To_v1alpha3_Components(in *unversioned.C
 	if err := Convert_unversioned_OptionalContainerConfig_To_v1alpha3_OptionalContainerConfig(&in.Scanner, &out.Scanner, s); err != nil {
 		return err
 	}
-	if err := Convert_unversioned_ContainerConfig_To_v1alpha3_ContainerConfig(&in.Remover, &out.Remover, s); err != nil {
+	if err := Convert_unversioned_RemoverConfig_To_v1alpha3_RemoverConfig(&in.Remover, &out.Remover, s); err != nil {
 		return err
 	}
 	return nil
@@ -245,13 +365,65 @@ func Convert_unversioned_ContainerConfig_To_v1alpha3_ContainerConfig(in *unversi
 	return autoConvert_unversioned_ContainerConfig_To_v1alpha3_ContainerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha3_DiskPressureTrigger_To_unversioned_DiskPressureTrigger(in *DiskPressureTrigger, out *unversioned.DiskPressureTrigger, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Cooldown = unversioned.Duration(in.Cooldown)
+	out.ImageFsUsagePercent = in.ImageFsUsagePercent
+	return nil
+}
+
+// Convert_v1alpha3_DiskPressureTrigger_To_unversioned_DiskPressureTrigger is an autogenerated conversion function.
+func Convert_v1alpha3_DiskPressureTrigger_To_unversioned_DiskPressureTrigger(in *DiskPressureTrigger, out *unversioned.DiskPressureTrigger, s conversion.Scope) error {
+	return autoConvert_v1alpha3_DiskPressureTrigger_To_unversioned_DiskPressureTrigger(in, out, s)
+}
+
+func autoConvert_unversioned_DiskPressureTrigger_To_v1alpha3_DiskPressureTrigger(in *unversioned.DiskPressureTrigger, out *DiskPressureTrigger, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Cooldown = Duration(in.Cooldown)
+	out.ImageFsUsagePercent = in.ImageFsUsagePercent
+	return nil
+}
+
+// Convert_unversioned_DiskPressureTrigger_To_v1alpha3_DiskPressureTrigger is an autogenerated conversion function.
+func Convert_unversioned_DiskPressureTrigger_To_v1alpha3_DiskPressureTrigger(in *unversioned.DiskPressureTrigger, out *DiskPressureTrigger, s conversion.Scope) error {
+	return autoConvert_unversioned_DiskPressureTrigger_To_v1alpha3_DiskPressureTrigger(in, out, s)
+}
+
+func autoConvert_v1alpha3_DiskUsagePolicy_To_unversioned_DiskUsagePolicy(in *DiskUsagePolicy, out *unversioned.DiskUsagePolicy, s conversion.Scope) error {
+	out.HighThresholdPercent = in.HighThresholdPercent
+	out.LowThresholdPercent = in.LowThresholdPercent
+	out.ImageFsPath = in.ImageFsPath
+	return nil
+}
+
+// Convert_v1alpha3_DiskUsagePolicy_To_unversioned_DiskUsagePolicy is an autogenerated conversion function.
+func Convert_v1alpha3_DiskUsagePolicy_To_unversioned_DiskUsagePolicy(in *DiskUsagePolicy, out *unversioned.DiskUsagePolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha3_DiskUsagePolicy_To_unversioned_DiskUsagePolicy(in, out, s)
+}
+
+func autoConvert_unversioned_DiskUsagePolicy_To_v1alpha3_DiskUsagePolicy(in *unversioned.DiskUsagePolicy, out *DiskUsagePolicy, s conversion.Scope) error {
+	out.HighThresholdPercent = in.HighThresholdPercent
+	out.LowThresholdPercent = in.LowThresholdPercent
+	out.ImageFsPath = in.ImageFsPath
+	return nil
+}
+
+// Convert_unversioned_DiskUsagePolicy_To_v1alpha3_DiskUsagePolicy is an autogenerated conversion function.
+func Convert_unversioned_DiskUsagePolicy_To_v1alpha3_DiskUsagePolicy(in *unversioned.DiskUsagePolicy, out *DiskUsagePolicy, s conversion.Scope) error {
+	return autoConvert_unversioned_DiskUsagePolicy_To_v1alpha3_DiskUsagePolicy(in, out, s)
+}
+
 func autoConvert_v1alpha3_EraserConfig_To_unversioned_EraserConfig(in *EraserConfig, out *unversioned.EraserConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha3_ManagerConfig_To_unversioned_ManagerConfig(&in.Manager, &out.Manager, s); err != nil {
 		return err
 	}
 	if err := Convert_v1alpha3_Components_To_unversioned_Components(&in.Components, &out.Components, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha3_EraserConfigStatus_To_unversioned_EraserConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -261,12 +433,16 @@ func Convert_v1alpha3_EraserConfig_To_unversioned_EraserConfig(in *EraserConfig,
 }
 
 func autoConvert_unversioned_EraserConfig_To_v1alpha3_EraserConfig(in *unversioned.EraserConfig, out *EraserConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_unversioned_ManagerConfig_To_v1alpha3_ManagerConfig(&in.Manager, &out.Manager, s); err != nil {
 		return err
 	}
 	if err := Convert_unversioned_Components_To_v1alpha3_Components(&in.Components, &out.Components, s); err != nil {
 		return err
 	}
+	if err := Convert_unversioned_EraserConfigStatus_To_v1alpha3_EraserConfigStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -275,6 +451,72 @@ func Convert_unversioned_EraserConfig_To_v1alpha3_EraserConfig(in *unversioned.E
 	return autoConvert_unversioned_EraserConfig_To_v1alpha3_EraserConfig(in, out, s)
 }
 
+func autoConvert_v1alpha3_EraserConfigList_To_unversioned_EraserConfigList(in *EraserConfigList, out *unversioned.EraserConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]unversioned.EraserConfig, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_EraserConfig_To_unversioned_EraserConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_EraserConfigList_To_unversioned_EraserConfigList is an autogenerated conversion function.
+func Convert_v1alpha3_EraserConfigList_To_unversioned_EraserConfigList(in *EraserConfigList, out *unversioned.EraserConfigList, s conversion.Scope) error {
+	return autoConvert_v1alpha3_EraserConfigList_To_unversioned_EraserConfigList(in, out, s)
+}
+
+func autoConvert_unversioned_EraserConfigList_To_v1alpha3_EraserConfigList(in *unversioned.EraserConfigList, out *EraserConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EraserConfig, len(*in))
+		for i := range *in {
+			if err := Convert_unversioned_EraserConfig_To_v1alpha3_EraserConfig(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_unversioned_EraserConfigList_To_v1alpha3_EraserConfigList is an autogenerated conversion function.
+func Convert_unversioned_EraserConfigList_To_v1alpha3_EraserConfigList(in *unversioned.EraserConfigList, out *EraserConfigList, s conversion.Scope) error {
+	return autoConvert_unversioned_EraserConfigList_To_v1alpha3_EraserConfigList(in, out, s)
+}
+
+func autoConvert_v1alpha3_EraserConfigStatus_To_unversioned_EraserConfigStatus(in *EraserConfigStatus, out *unversioned.EraserConfigStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Accepted = in.Accepted
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha3_EraserConfigStatus_To_unversioned_EraserConfigStatus is an autogenerated conversion function.
+func Convert_v1alpha3_EraserConfigStatus_To_unversioned_EraserConfigStatus(in *EraserConfigStatus, out *unversioned.EraserConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha3_EraserConfigStatus_To_unversioned_EraserConfigStatus(in, out, s)
+}
+
+func autoConvert_unversioned_EraserConfigStatus_To_v1alpha3_EraserConfigStatus(in *unversioned.EraserConfigStatus, out *EraserConfigStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Accepted = in.Accepted
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_unversioned_EraserConfigStatus_To_v1alpha3_EraserConfigStatus is an autogenerated conversion function.
+func Convert_unversioned_EraserConfigStatus_To_v1alpha3_EraserConfigStatus(in *unversioned.EraserConfigStatus, out *EraserConfigStatus, s conversion.Scope) error {
+	return autoConvert_unversioned_EraserConfigStatus_To_v1alpha3_EraserConfigStatus(in, out, s)
+}
+
 func autoConvert_v1alpha3_ImageJobCleanupConfig_To_unversioned_ImageJobCleanupConfig(in *ImageJobCleanupConfig, out *unversioned.ImageJobCleanupConfig, s conversion.Scope) error {
 	out.DelayOnSuccess = unversioned.Duration(in.DelayOnSuccess)
 	out.DelayOnFailure = unversioned.Duration(in.DelayOnFailure)
@@ -344,6 +586,10 @@ func autoConvert_v1alpha3_ManagerConfig_To_unversioned_ManagerConfig(in *Manager
 	}
 	out.PriorityClassName = in.PriorityClassName
 	out.AdditionalPodLabels = *(*map[string]string)(unsafe.Pointer(&in.AdditionalPodLabels))
+	out.DryRun = in.DryRun
+	if err := Convert_v1alpha3_CollectorPolicy_To_unversioned_CollectorPolicy(&in.CollectorPolicy, &out.CollectorPolicy, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -373,6 +619,10 @@ func autoConvert_unversioned_ManagerConfig_To_v1alpha3_ManagerConfig(in *unversi
 	}
 	out.PriorityClassName = in.PriorityClassName
 	out.AdditionalPodLabels = *(*map[string]string)(unsafe.Pointer(&in.AdditionalPodLabels))
+	out.DryRun = in.DryRun
+	if err := Convert_unversioned_CollectorPolicy_To_v1alpha3_CollectorPolicy(&in.CollectorPolicy, &out.CollectorPolicy, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -451,6 +701,36 @@ func Convert_unversioned_ProfileConfig_To_v1alpha3_ProfileConfig(in *unversioned
 	return autoConvert_unversioned_ProfileConfig_To_v1alpha3_ProfileConfig(in, out, s)
 }
 
+func autoConvert_v1alpha3_RemoverConfig_To_unversioned_RemoverConfig(in *RemoverConfig, out *unversioned.RemoverConfig, s conversion.Scope) error {
+	if err := Convert_v1alpha3_ContainerConfig_To_unversioned_ContainerConfig(&in.ContainerConfig, &out.ContainerConfig, s); err != nil {
+		return err
+	}
+	out.Concurrency = in.Concurrency
+	out.DeletesPerSecond = in.DeletesPerSecond
+	out.Timeout = unversioned.Duration(in.Timeout)
+	return nil
+}
+
+// Convert_v1alpha3_RemoverConfig_To_unversioned_RemoverConfig is an autogenerated conversion function.
+func Convert_v1alpha3_RemoverConfig_To_unversioned_RemoverConfig(in *RemoverConfig, out *unversioned.RemoverConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha3_RemoverConfig_To_unversioned_RemoverConfig(in, out, s)
+}
+
+func autoConvert_unversioned_RemoverConfig_To_v1alpha3_RemoverConfig(in *unversioned.RemoverConfig, out *RemoverConfig, s conversion.Scope) error {
+	if err := Convert_unversioned_ContainerConfig_To_v1alpha3_ContainerConfig(&in.ContainerConfig, &out.ContainerConfig, s); err != nil {
+		return err
+	}
+	out.Concurrency = in.Concurrency
+	out.DeletesPerSecond = in.DeletesPerSecond
+	out.Timeout = Duration(in.Timeout)
+	return nil
+}
+
+// Convert_unversioned_RemoverConfig_To_v1alpha3_RemoverConfig is an autogenerated conversion function.
+func Convert_unversioned_RemoverConfig_To_v1alpha3_RemoverConfig(in *unversioned.RemoverConfig, out *RemoverConfig, s conversion.Scope) error {
+	return autoConvert_unversioned_RemoverConfig_To_v1alpha3_RemoverConfig(in, out, s)
+}
+
 func autoConvert_v1alpha3_RepoTag_To_unversioned_RepoTag(in *RepoTag, out *unversioned.RepoTag, s conversion.Scope) error {
 	out.Repo = in.Repo
 	out.Tag = in.Tag
@@ -520,6 +800,12 @@ func Convert_unversioned_RuntimeSpec_To_v1alpha3_RuntimeSpec(in *unversioned.Run
 func autoConvert_v1alpha3_ScheduleConfig_To_unversioned_ScheduleConfig(in *ScheduleConfig, out *unversioned.ScheduleConfig, s conversion.Scope) error {
 	out.RepeatInterval = unversioned.Duration(in.RepeatInterval)
 	out.BeginImmediately = in.BeginImmediately
+	out.Cron = in.Cron
+	out.TimeZone = in.TimeZone
+	out.BlackoutWindows = *(*[]unversioned.BlackoutWindow)(unsafe.Pointer(&in.BlackoutWindows))
+	if err := Convert_v1alpha3_DiskPressureTrigger_To_unversioned_DiskPressureTrigger(&in.DiskPressure, &out.DiskPressure, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -531,6 +817,12 @@ func Convert_v1alpha3_ScheduleConfig_To_unversioned_ScheduleConfig(in *ScheduleC
 func autoConvert_unversioned_ScheduleConfig_To_v1alpha3_ScheduleConfig(in *unversioned.ScheduleConfig, out *ScheduleConfig, s conversion.Scope) error {
 	out.RepeatInterval = Duration(in.RepeatInterval)
 	out.BeginImmediately = in.BeginImmediately
+	out.Cron = in.Cron
+	out.TimeZone = in.TimeZone
+	out.BlackoutWindows = *(*[]BlackoutWindow)(unsafe.Pointer(&in.BlackoutWindows))
+	if err := Convert_unversioned_DiskPressureTrigger_To_v1alpha3_DiskPressureTrigger(&in.DiskPressure, &out.DiskPressure, s); err != nil {
+		return err
+	}
 	return nil
 }
 
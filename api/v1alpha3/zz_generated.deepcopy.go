@@ -25,6 +25,37 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackoutWindow) DeepCopyInto(out *BlackoutWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackoutWindow.
+func (in *BlackoutWindow) DeepCopy() *BlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectorPolicy) DeepCopyInto(out *CollectorPolicy) {
+	*out = *in
+	out.DiskUsage = in.DiskUsage
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectorPolicy.
+func (in *CollectorPolicy) DeepCopy() *CollectorPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectorPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Components) DeepCopyInto(out *Components) {
 	*out = *in
@@ -73,12 +104,44 @@ func (in *ContainerConfig) DeepCopy() *ContainerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskPressureTrigger) DeepCopyInto(out *DiskPressureTrigger) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskPressureTrigger.
+func (in *DiskPressureTrigger) DeepCopy() *DiskPressureTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskPressureTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskUsagePolicy) DeepCopyInto(out *DiskUsagePolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskUsagePolicy.
+func (in *DiskUsagePolicy) DeepCopy() *DiskUsagePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskUsagePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EraserConfig) DeepCopyInto(out *EraserConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Manager.DeepCopyInto(&out.Manager)
 	in.Components.DeepCopyInto(&out.Components)
+	out.Status = in.Status
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfig.
@@ -99,6 +162,53 @@ func (in *EraserConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EraserConfigList) DeepCopyInto(out *EraserConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EraserConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfigList.
+func (in *EraserConfigList) DeepCopy() *EraserConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(EraserConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EraserConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EraserConfigStatus) DeepCopyInto(out *EraserConfigStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EraserConfigStatus.
+func (in *EraserConfigStatus) DeepCopy() *EraserConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EraserConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageJobCleanupConfig) DeepCopyInto(out *ImageJobCleanupConfig) {
 	*out = *in
@@ -134,7 +244,7 @@ func (in *ImageJobConfig) DeepCopy() *ImageJobConfig {
 func (in *ManagerConfig) DeepCopyInto(out *ManagerConfig) {
 	*out = *in
 	out.Runtime = in.Runtime
-	out.Scheduling = in.Scheduling
+	in.Scheduling.DeepCopyInto(&out.Scheduling)
 	out.Profile = in.Profile
 	out.ImageJob = in.ImageJob
 	if in.PullSecrets != nil {
@@ -150,6 +260,7 @@ func (in *ManagerConfig) DeepCopyInto(out *ManagerConfig) {
 			(*out)[key] = val
 		}
 	}
+	out.CollectorPolicy = in.CollectorPolicy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagerConfig.
@@ -213,6 +324,22 @@ func (in *ProfileConfig) DeepCopy() *ProfileConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoverConfig) DeepCopyInto(out *RemoverConfig) {
+	*out = *in
+	in.ContainerConfig.DeepCopyInto(&out.ContainerConfig)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoverConfig.
+func (in *RemoverConfig) DeepCopy() *RemoverConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoverConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepoTag) DeepCopyInto(out *RepoTag) {
 	*out = *in
@@ -263,6 +390,12 @@ func (in *RuntimeSpec) DeepCopy() *RuntimeSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduleConfig) DeepCopyInto(out *ScheduleConfig) {
 	*out = *in
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]BlackoutWindow, len(*in))
+		copy(*out, *in)
+	}
+	out.DiskPressure = in.DiskPressure
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleConfig.
@@ -132,25 +132,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result
 	}
 
 	p := corev1.PodList{}
-	err = r.List(ctx, &p, client.MatchingLabels{
-		"control-plane": "controller-manager",
+	err = r.List(ctx, &p, client.InNamespace(eraserUtils.GetNamespace()), client.MatchingLabels{
+		controllerUtils.ManagerPodLabelKey: controllerUtils.ManagerPodLabelValue,
 	})
 	if err != nil {
 		return ctrl.Result{}, err
 	}
-	pods := p.Items
-
-	if len(pods) == 0 {
-		return ctrl.Result{}, nil
-	}
-
-	pod := pods[0]
-	for i := range pods[1:] {
-		if pods[i].Status.Phase == corev1.PodPhase(corev1.PodRunning) {
-			pod = pods[i]
-			break
-		}
-	}
 
 	// the configmap is mounted to the filesystem, but the normal
 	// reconciliation loop will not update it on the node's filesystem until
@@ -164,14 +151,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result
 	// crypto/rand library.
 	//nolint:all
 	newVersion := fmt.Sprintf("%d", rand.Int63())
-	if pod.Annotations == nil {
-		pod.Annotations = make(map[string]string)
-	}
-	pod.Annotations["eraser.sh/configVersion"] = newVersion
 
-	err = r.Update(ctx, &pod)
-	if err != nil {
-		return ctrl.Result{}, err
+	// every replica of the manager reads the configuration, not only the
+	// leader running this reconciler.
+	for i := range p.Items {
+		pod := &p.Items[i]
+		if pod.Status.Phase != corev1.PodRunning || !pod.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		if pod.Annotations == nil {
+			pod.Annotations = make(map[string]string)
+		}
+		pod.Annotations["eraser.sh/configVersion"] = newVersion
+
+		if err := r.Update(ctx, pod); err != nil {
+			return ctrl.Result{}, err
+		}
 	}
+
 	return ctrl.Result{}, nil
 }
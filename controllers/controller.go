@@ -6,6 +6,7 @@ import (
 
 	"github.com/eraser-dev/eraser/api/unversioned/config"
 	"github.com/eraser-dev/eraser/controllers/configmap"
+	"github.com/eraser-dev/eraser/controllers/eraserconfig"
 	"github.com/eraser-dev/eraser/controllers/imagecollector"
 	"github.com/eraser-dev/eraser/controllers/imagejob"
 	"github.com/eraser-dev/eraser/controllers/imagelist"
@@ -24,6 +25,7 @@ var (
 		imagejob.Add,
 		imagecollector.Add,
 		configmap.Add,
+		eraserconfig.Add,
 	}
 )
 
@@ -0,0 +1,164 @@
+// Package eraserconfig applies the configuration from EraserConfig resources
+// and reports in their status whether it was accepted.
+package eraserconfig
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	"github.com/eraser-dev/eraser/api/unversioned/config"
+	"github.com/eraser-dev/eraser/api/v1alpha3"
+	"github.com/eraser-dev/eraser/controllers/util"
+)
+
+var log = logf.Log.WithName("controller").WithValues("process", "eraserconfig-controller")
+
+// Reconciler reconciles EraserConfig objects.
+type Reconciler struct {
+	client.Client
+	eraserConfig *config.Manager
+	elected      <-chan struct{}
+}
+
+// Add creates the EraserConfig controller. It runs on every replica of the
+// manager, as every replica needs the configuration, but only the leader
+// writes the status. The EraserConfigs are reconciled again once a replica is
+// elected, so that the status of the ones it reconciled before is written.
+func Add(mgr manager.Manager, cfg *config.Manager) error {
+	r := &Reconciler{
+		Client:       mgr.GetClient(),
+		eraserConfig: cfg,
+		elected:      mgr.Elected(),
+	}
+
+	c, err := controller.New("eraserconfig-controller", mgr, controller.Options{
+		Reconciler:         r,
+		NeedLeaderElection: ptr.To(false),
+	})
+	if err != nil {
+		return err
+	}
+
+	err = c.Watch(
+		source.Kind(mgr.GetCache(), &v1alpha3.EraserConfig{}),
+		&handler.EnqueueRequestForObject{},
+		// status updates do not change the generation
+		predicate.GenerationChangedPredicate{},
+	)
+	if err != nil {
+		return err
+	}
+
+	ch := make(chan event.GenericEvent)
+	err = c.Watch(&source.Channel{
+		Source: ch,
+	}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return err
+	}
+
+	// the runnable only starts on the leader
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return r.resync(ctx, ch)
+	}))
+}
+
+// resync queues a reconcile of every EraserConfig.
+func (r *Reconciler) resync(ctx context.Context, ch chan<- event.GenericEvent) error {
+	eraserConfigs := &v1alpha3.EraserConfigList{}
+	if err := r.List(ctx, eraserConfigs); err != nil {
+		return err
+	}
+
+	for i := range eraserConfigs.Items {
+		select {
+		case ch <- event.GenericEvent{Object: &eraserConfigs.Items[i]}:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	return nil
+}
+
+//+kubebuilder:rbac:groups=eraser.sh,resources=eraserconfigs,verbs=get;list;watch
+//+kubebuilder:rbac:groups=eraser.sh,resources=eraserconfigs/status,verbs=get;update;patch
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	eraserConfig := &v1alpha3.EraserConfig{}
+	if err := r.Get(ctx, req.NamespacedName, eraserConfig); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+
+		if req.Name == util.EraserConfigName && r.eraserConfig.Overridden() {
+			log.Info("EraserConfig was deleted, using the configuration from the configmap", "name", req.Name)
+			r.eraserConfig.ClearOverride()
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if eraserConfig.Name != util.EraserConfigName {
+		msg := fmt.Sprintf("ignored: only the EraserConfig named %q is used", util.EraserConfigName)
+		return ctrl.Result{}, r.setStatus(ctx, eraserConfig, false, msg)
+	}
+
+	cfg, err := parse(eraserConfig)
+	if err != nil {
+		log.Error(err, "EraserConfig was rejected, keeping the current configuration", "name", eraserConfig.Name)
+		return ctrl.Result{}, r.setStatus(ctx, eraserConfig, false, err.Error())
+	}
+
+	if err := r.eraserConfig.Override(cfg); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("applied configuration from EraserConfig", "name", eraserConfig.Name, "generation", eraserConfig.Generation)
+	return ctrl.Result{}, r.setStatus(ctx, eraserConfig, true, "")
+}
+
+func parse(eraserConfig *v1alpha3.EraserConfig) (*unversioned.EraserConfig, error) {
+	cfg, err := config.ParseResource(eraserConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := config.Validate(cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (r *Reconciler) setStatus(ctx context.Context, eraserConfig *v1alpha3.EraserConfig, accepted bool, msg string) error {
+	select {
+	case <-r.elected:
+	default:
+		return nil
+	}
+
+	status := v1alpha3.EraserConfigStatus{
+		ObservedGeneration: eraserConfig.Generation,
+		Accepted:           accepted,
+		Message:            msg,
+	}
+	if eraserConfig.Status == status {
+		return nil
+	}
+
+	eraserConfig.Status = status
+	return r.Status().Update(ctx, eraserConfig)
+}
@@ -0,0 +1,65 @@
+package imagecollector
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	"github.com/eraser-dev/eraser/api/v1alpha3"
+	"github.com/eraser-dev/eraser/controllers/util"
+	eraserUtils "github.com/eraser-dev/eraser/pkg/utils"
+)
+
+const (
+	activeConfigMapName = "eraser-active-config"
+	activeConfigKey     = "controller_manager_config.yaml"
+)
+
+// scannerConfigMap returns the name of the configmap the scanner reads its
+// configuration from. While an EraserConfig resource is in use, its
+// configuration is written to a configmap of its own, as the scanner would
+// otherwise see the configuration from the manager's configmap.
+func (r *Reconciler) scannerConfigMap(ctx context.Context, cfg *unversioned.EraserConfig) (string, error) {
+	if !r.eraserConfig.Overridden() {
+		return util.EraserConfigmapName, nil
+	}
+
+	var out v1alpha3.EraserConfig
+	if err := v1alpha3.Convert_unversioned_EraserConfig_To_v1alpha3_EraserConfig(cfg, &out, nil); err != nil {
+		return "", err
+	}
+	out.TypeMeta = metav1.TypeMeta{APIVersion: v1alpha3.GroupVersion.String(), Kind: "EraserConfig"}
+	out.ObjectMeta = metav1.ObjectMeta{}
+	out.Status = v1alpha3.EraserConfigStatus{}
+
+	b, err := yaml.Marshal(&out)
+	if err != nil {
+		return "", err
+	}
+	data := map[string]string{activeConfigKey: string(b)}
+
+	cm := corev1.ConfigMap{}
+	key := types.NamespacedName{Namespace: eraserUtils.GetNamespace(), Name: activeConfigMapName}
+	err = r.apiReader.Get(ctx, key, &cm)
+	if apierrors.IsNotFound(err) {
+		cm = corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      activeConfigMapName,
+				Namespace: eraserUtils.GetNamespace(),
+			},
+			Data: data,
+		}
+		return activeConfigMapName, r.Create(ctx, &cm)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	cm.Data = data
+	return activeConfigMapName, r.Update(ctx, &cm)
+}
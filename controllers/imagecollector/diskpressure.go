@@ -0,0 +1,216 @@
+package imagecollector
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	eraserv1 "github.com/eraser-dev/eraser/api/v1"
+	"github.com/eraser-dev/eraser/controllers/util"
+	"github.com/eraser-dev/eraser/pkg/schedule"
+)
+
+const (
+	// the name of the request that checks the nodes for disk pressure
+	diskPressureRequest = "disk-pressure"
+	// how often nodes under disk pressure are checked again while another
+	// collector ImageJob runs
+	diskPressureRetryInterval = time.Minute
+)
+
+// diskPressureTrigger returns the disk pressure trigger, or nil if it is
+// disabled.
+func (r *Reconciler) diskPressureTrigger() *unversioned.DiskPressureTrigger {
+	c, err := r.eraserConfig.Read()
+	if err != nil || !c.Components.Collector.Enabled || !c.Manager.Scheduling.DiskPressure.Enabled {
+		return nil
+	}
+
+	return &c.Manager.Scheduling.DiskPressure
+}
+
+// nodeHandler maps the events of all nodes to the disk pressure request.
+func nodeHandler() handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(context.Context, client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: diskPressureRequest}}}
+	})
+}
+
+// nodePredicate passes the nodes that come under disk pressure. Nodes that
+// stay under pressure are checked again at the end of their cooldown.
+func (r *Reconciler) nodePredicate() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			trigger := r.diskPressureTrigger()
+			node, ok := e.Object.(*corev1.Node)
+			return trigger != nil && ok && underPressure(node, trigger)
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			trigger := r.diskPressureTrigger()
+			oldNode, oldOk := e.ObjectOld.(*corev1.Node)
+			newNode, newOk := e.ObjectNew.(*corev1.Node)
+			return trigger != nil && oldOk && newOk && underPressure(newNode, trigger) && !underPressure(oldNode, trigger)
+		},
+		DeleteFunc:  util.NeverOnDelete,
+		GenericFunc: util.NeverOnGeneric,
+	}
+}
+
+// underPressure tells whether the node reports the DiskPressure condition, or
+// an image filesystem usage at or above the threshold of the trigger.
+func underPressure(node *corev1.Node, trigger *unversioned.DiskPressureTrigger) bool {
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeDiskPressure && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+
+	if trigger.ImageFsUsagePercent == 0 {
+		return false
+	}
+
+	usage, ok := node.Annotations[util.ImageFsUsageAnnotationKey]
+	if !ok {
+		return false
+	}
+
+	percent, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(usage), "%"), 64)
+	if err != nil {
+		log.Info("invalid image filesystem usage annotation", "node", node.Name, "value", usage)
+		return false
+	}
+
+	return percent >= float64(trigger.ImageFsUsagePercent)
+}
+
+// handleDiskPressure starts a collector ImageJob on the nodes under disk
+// pressure that were not triggered during their cooldown. While another
+// collector ImageJob runs or a blackout window is in effect, the nodes are
+// checked again later.
+func (r *Reconciler) handleDiskPressure(ctx context.Context, jobs []eraserv1.ImageJob) (ctrl.Result, error) {
+	eraserConfig, err := r.eraserConfig.Read()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	trigger := eraserConfig.Manager.Scheduling.DiskPressure
+	if !trigger.Enabled || !eraserConfig.Components.Collector.Enabled {
+		return ctrl.Result{}, nil
+	}
+
+	nodes := corev1.NodeList{}
+	if err := r.List(ctx, &nodes); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	state, err := r.readState(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	now := time.Now()
+	cooldown := time.Duration(trigger.Cooldown)
+
+	// the earliest end of a cooldown, to check the nodes that are still under
+	// pressure again
+	var recheck time.Duration
+	var pressured []string
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if !underPressure(node, &trigger) {
+			continue
+		}
+
+		if until := state.Triggered[node.Name].Add(cooldown).Sub(now); until > 0 {
+			if recheck == 0 || until < recheck {
+				recheck = until
+			}
+			continue
+		}
+
+		pressured = append(pressured, node.Name)
+	}
+
+	if len(pressured) == 0 {
+		return ctrl.Result{RequeueAfter: recheck}, nil
+	}
+
+	if len(jobs) > 0 {
+		log.Info("Collector imagejob is running, delaying disk pressure imagejob", "nodes", pressured)
+		return ctrl.Result{RequeueAfter: diskPressureRetryInterval}, nil
+	}
+
+	sched, err := schedule.New(&eraserConfig.Manager.Scheduling)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if sched.InBlackout(now) {
+		allowed := sched.Allowed(now)
+		if allowed.IsZero() {
+			return ctrl.Result{}, fmt.Errorf("the collector schedule has no run outside of the blackout windows")
+		}
+
+		log.Info("In a blackout window, delaying disk pressure imagejob", "nodes", pressured, "until", allowed)
+		return ctrl.Result{RequeueAfter: allowed.Sub(now)}, nil
+	}
+
+	// the cooldown is stored before the job is created, so that a failure
+	// to store it cannot start a job for the same nodes again
+	err = r.updateState(ctx, func(s *scheduleState) {
+		triggered := map[string]time.Time{}
+		for name, t := range s.Triggered {
+			if now.Sub(t) < cooldown {
+				triggered[name] = t
+			}
+		}
+		for _, name := range pressured {
+			triggered[name] = now
+		}
+		s.Triggered = triggered
+	})
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Nodes under disk pressure, starting collector imagejob", "nodes", pressured)
+	if _, err := r.createImageJob(ctx, pressured); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{RequeueAfter: cooldown}, nil
+}
+
+// triggeredByDiskPressure tells whether the job was started for nodes under
+// disk pressure rather than by the schedule.
+func triggeredByDiskPressure(job *eraserv1.ImageJob) bool {
+	_, ok := job.Annotations[util.ImageJobNodesAnnotationKey]
+	return ok
+}
+
+// resumeSchedule goes on with the schedule after a job for nodes under disk
+// pressure, as if the job had not run.
+func (r *Reconciler) resumeSchedule(ctx context.Context) (ctrl.Result, error) {
+	eraserConfig, err := r.eraserConfig.Read()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if !eraserConfig.Components.Collector.Enabled {
+		return ctrl.Result{}, nil
+	}
+
+	return r.restoreSchedule(ctx, nil)
+}
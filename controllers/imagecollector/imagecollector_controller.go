@@ -24,10 +24,12 @@ import (
 	"os/signal"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"go.opentelemetry.io/otel"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,6 +38,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/eraser-dev/eraser/api/unversioned"
 	"github.com/eraser-dev/eraser/api/unversioned/config"
 	eraserv1 "github.com/eraser-dev/eraser/api/v1"
 	eraserv1alpha1 "github.com/eraser-dev/eraser/api/v1alpha1"
@@ -48,17 +51,21 @@ import (
 
 	"github.com/eraser-dev/eraser/pkg/logger"
 	"github.com/eraser-dev/eraser/pkg/metrics"
+	"github.com/eraser-dev/eraser/pkg/schedule"
 	eraserUtils "github.com/eraser-dev/eraser/pkg/utils"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 )
 
 const (
-	ownerLabelValue  = "imagecollector"
-	configVolumeName = "eraser-config"
+	ownerLabelValue      = "imagecollector"
+	configVolumeName     = "eraser-config"
+	imageUsageVolumeName = "image-usage"
+	imageFsVolumeName    = "imagefs"
 )
 
 var (
@@ -68,6 +75,8 @@ var (
 	exporter   sdkmetric.Exporter
 	reader     sdkmetric.Reader
 	provider   *sdkmetric.MeterProvider
+
+	hostPathDirectoryOrCreate = corev1.HostPathDirectoryOrCreate
 )
 
 func init() {
@@ -83,7 +92,9 @@ func init() {
 // ImageCollectorReconciler reconciles a ImageCollector object.
 type Reconciler struct {
 	client.Client
+	apiReader    client.Reader
 	Scheme       *runtime.Scheme
+	recorder     record.EventRecorder
 	eraserConfig *config.Manager
 }
 
@@ -93,10 +104,10 @@ func Add(mgr manager.Manager, cfg *config.Manager) error {
 		return err
 	}
 
-	collCfg := c.Components.Collector
-	if !collCfg.Enabled {
-		// don't add controller, but don't throw an error either
-		return nil
+	// the controller is added even if the collector is disabled, so that it
+	// can be enabled without restarting the manager
+	if !c.Components.Collector.Enabled {
+		log.Info("collector is disabled, no collector imagejobs will be scheduled until it is enabled")
 	}
 
 	r, err := newReconciler(mgr, cfg)
@@ -125,7 +136,9 @@ func newReconciler(mgr manager.Manager, cfg *config.Manager) (*Reconciler, error
 
 	rec := &Reconciler{
 		Client:       mgr.GetClient(),
+		apiReader:    mgr.GetAPIReader(),
 		Scheme:       mgr.GetScheme(),
+		recorder:     mgr.GetEventRecorderFor("imagecollector-controller"),
 		eraserConfig: cfg,
 	}
 
@@ -162,45 +175,82 @@ func add(mgr manager.Manager, r *Reconciler) error {
 		return err
 	}
 
-	ch := make(chan event.GenericEvent)
-	err = c.Watch(&source.Channel{
-		Source: ch,
-	}, &handler.EnqueueRequestForObject{})
+	err = c.Watch(source.Kind(mgr.GetCache(), &corev1.Node{}), nodeHandler(), r.nodePredicate())
 	if err != nil {
 		return err
 	}
 
-	eraserConfig, err := r.eraserConfig.Read()
+	ch := make(chan event.GenericEvent)
+	err = c.Watch(&source.Channel{
+		Source: ch,
+	}, &handler.EnqueueRequestForObject{})
 	if err != nil {
 		return err
 	}
 
-	scheduleCfg := eraserConfig.Manager.Scheduling
-	delay := time.Duration(scheduleCfg.RepeatInterval)
-	if scheduleCfg.BeginImmediately {
-		delay = 0 * time.Second
-	}
-
-	log.V(1).Info("delay", "delay", delay)
+	// the runnable only starts on the leader, together with the controller
+	updates := r.eraserConfig.Subscribe()
+	return mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return r.startWhenEnabled(ctx, updates, ch)
+	}))
+}
 
-	// runs the provided function after the specified delay
-	_ = time.AfterFunc(delay, func() {
-		log.Info("Queueing first ImageCollector reconcile...")
-		ch <- event.GenericEvent{
+// startWhenEnabled queues the first reconcile, which restores the schedule
+// stored in the cluster or starts a new one, whenever the collector becomes
+// enabled: when the manager starts, and when the configuration changes. The
+// nodes are also checked for disk pressure on every change, as nodes that
+// were already under pressure do not send events.
+func (r *Reconciler) startWhenEnabled(ctx context.Context, updates <-chan struct{}, ch chan<- event.GenericEvent) error {
+	queue := func(name string) bool {
+		select {
+		case ch <- event.GenericEvent{
 			Object: &eraserv1.ImageJob{
 				ObjectMeta: metav1.ObjectMeta{
-					Name: "first-reconcile",
+					Name: name,
 				},
 			},
+		}:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	enabled := false
+	for {
+		c, err := r.eraserConfig.Read()
+		if err != nil {
+			return err
+		}
+
+		switch now := c.Components.Collector.Enabled; {
+		case now && !enabled:
+			log.Info("Queueing first ImageCollector reconcile...")
+			if !queue("first-reconcile") {
+				return nil
+			}
+		case !now && enabled:
+			log.Info("collector was disabled, no further collector imagejobs will be scheduled")
+		}
+		enabled = c.Components.Collector.Enabled
+
+		if enabled && c.Manager.Scheduling.DiskPressure.Enabled && !queue(diskPressureRequest) {
+			return nil
 		}
-	})
 
-	return nil
+		select {
+		case <-updates:
+		case <-ctx.Done():
+			return nil
+		}
+	}
 }
 
 //+kubebuilder:rbac:groups=eraser.sh,resources=imagelists,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",namespace="system",resources=podtemplates,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=eraser.sh,resources=imagelists/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=eraser.sh,resources=imageexclusions,verbs=get;list;watch
+//+kubebuilder:rbac:groups=eraser.sh,resources=imageexclusions/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",namespace="system",resources=pods,verbs=get;list;watch;update;create;delete
 
@@ -218,27 +268,49 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	defer log.Info("done reconcile")
 
 	imageJobList := &eraserv1.ImageJobList{}
-	if err := r.List(ctx, imageJobList); err != nil {
+	if err := r.List(ctx, imageJobList, client.MatchingLabelsSelector{Selector: ownerLabel}); err != nil {
 		log.Info("could not list imagejobs")
 		return ctrl.Result{}, err
 	}
 
+	eraserConfig, err := r.eraserConfig.Read()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// a job that is already running when the collector is disabled is still
+	// cleaned up, but no new job is started
+	if len(imageJobList.Items) == 0 && !eraserConfig.Components.Collector.Enabled {
+		log.Info("collector is disabled, not scheduling a collector imagejob")
+		return ctrl.Result{}, nil
+	}
+
 	if req.Name == "first-reconcile" {
-		for idx := range imageJobList.Items {
-			if err := r.Delete(ctx, &imageJobList.Items[idx]); err != nil {
-				log.Info("error cleaning up previous imagejobs")
-				return ctrl.Result{}, err
-			}
-		}
-		return r.createImageJob(ctx)
+		return r.restoreSchedule(ctx, imageJobList.Items)
+	}
+
+	if req.Name == diskPressureRequest {
+		return r.handleDiskPressure(ctx, imageJobList.Items)
 	}
 
 	switch len(imageJobList.Items) {
 	case 0:
 		// If we reach this point, reconcile has been called on a timer, and we want to begin a
-		// collector ImageJob
-		return r.createImageJob(ctx)
+		// collector ImageJob. a timer left over from before the collector
+		// was disabled and enabled again may fire early.
+		state, err := r.readState(ctx)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if until := time.Until(state.NextRun); until > 0 {
+			return ctrl.Result{RequeueAfter: until}, nil
+		}
+		return r.startImageJob(ctx)
 	case 1:
+		if !util.IsCompletedOrFailed(imageJobList.Items[0].Status.Phase) {
+			log.Info("collector imagejob is still running", "job", imageJobList.Items[0].Name)
+			return ctrl.Result{}, nil
+		}
 		// an imagejob has just completed; proceed to imagelist creation.
 		return r.handleCompletedImageJob(ctx, &imageJobList.Items[0])
 	default:
@@ -258,6 +330,7 @@ func (r *Reconciler) handleJobDeletion(ctx context.Context, job *eraserv1.ImageJ
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	r.recorder.Eventf(job, corev1.EventTypeNormal, util.EventReasonJobDeleted, "Deleted collector imagejob %s", job.Name)
 
 	template := corev1.PodTemplate{}
 	if err := r.Get(ctx,
@@ -267,7 +340,8 @@ func (r *Reconciler) handleJobDeletion(ctx context.Context, job *eraserv1.ImageJ
 		},
 		&template,
 	); err != nil {
-		return ctrl.Result{}, err
+		// the template of an interrupted job is already gone
+		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
 	log.Info("Deleting pod template", "template", template.Name)
@@ -279,7 +353,127 @@ func (r *Reconciler) handleJobDeletion(ctx context.Context, job *eraserv1.ImageJ
 	return ctrl.Result{}, nil
 }
 
-func (r *Reconciler) createImageJob(ctx context.Context) (ctrl.Result, error) {
+// restoreSchedule picks up the collector schedule where it was left when the
+// manager stopped. A running ImageJob is left to complete if its pods are
+// still around, and marked as failed otherwise. Without a job, the next run
+// happens at the stored time, immediately if that time was missed.
+func (r *Reconciler) restoreSchedule(ctx context.Context, jobs []eraserv1.ImageJob) (ctrl.Result, error) {
+	for i := range jobs {
+		job := &jobs[i]
+
+		if util.IsCompletedOrFailed(job.Status.Phase) {
+			log.Info("Resuming cleanup of collector imagejob", "job", job.Name)
+			return r.handleCompletedImageJob(ctx, job)
+		}
+
+		template := corev1.PodTemplate{}
+		err := r.Get(ctx, types.NamespacedName{Namespace: eraserUtils.GetNamespace(), Name: job.Name}, &template)
+		if err == nil {
+			log.Info("Resuming running collector imagejob", "job", job.Name)
+			return ctrl.Result{}, nil
+		}
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+
+		// the pods of the job went away with the previous manager pod
+		log.Info("Collector imagejob was interrupted, marking it as failed", "job", job.Name)
+		job.Status.Phase = eraserv1.PhaseFailed
+		util.MarkFailed(&job.Status.Conditions, job.Generation, util.ConditionReasonInterrupted,
+			"The pods of the job went away with the previous manager pod")
+		if err := r.Status().Update(ctx, job); err != nil {
+			return ctrl.Result{}, err
+		}
+		return r.handleCompletedImageJob(ctx, job)
+	}
+
+	state, err := r.readState(ctx)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	now := time.Now()
+	next := state.NextRun
+	if next.IsZero() {
+		eraserConfig, err := r.eraserConfig.Read()
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		sched, err := schedule.New(&eraserConfig.Manager.Scheduling)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		next = sched.First(now)
+		if next.IsZero() {
+			return ctrl.Result{}, fmt.Errorf("the collector schedule has no run outside of the blackout windows")
+		}
+
+		if err := r.updateState(ctx, func(s *scheduleState) { s.NextRun = next }); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if next.After(now) {
+		log.Info("Next collector imagejob scheduled", "time", next)
+		return ctrl.Result{RequeueAfter: next.Sub(now)}, nil
+	}
+
+	return r.startImageJob(ctx)
+}
+
+// startImageJob creates a collector ImageJob, unless a blackout window is in
+// effect, in which case the job is delayed until the end of the window.
+func (r *Reconciler) startImageJob(ctx context.Context) (ctrl.Result, error) {
+	eraserConfig, err := r.eraserConfig.Read()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	sched, err := schedule.New(&eraserConfig.Manager.Scheduling)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	now := time.Now()
+	if sched.InBlackout(now) {
+		allowed := sched.Allowed(now)
+		if allowed.IsZero() {
+			return ctrl.Result{}, fmt.Errorf("the collector schedule has no run outside of the blackout windows")
+		}
+
+		log.Info("In a blackout window, delaying collector imagejob", "until", allowed)
+		return ctrl.Result{RequeueAfter: allowed.Sub(now)}, nil
+	}
+
+	return r.createImageJob(ctx, nil)
+}
+
+// recordRun stores the start of a collector ImageJob.
+func (r *Reconciler) recordRun(ctx context.Context, jobName string) error {
+	return r.updateState(ctx, func(s *scheduleState) {
+		s.LastRun = time.Now()
+		s.LastJob = jobName
+		s.NextRun = time.Time{}
+	})
+}
+
+// recordCompletion stores the outcome of a collector ImageJob and the time of
+// the next one.
+func (r *Reconciler) recordCompletion(ctx context.Context, job *eraserv1.ImageJob, completed, next time.Time) error {
+	return r.updateState(ctx, func(s *scheduleState) {
+		s.LastJob = job.Name
+		s.LastCompleted = completed
+		s.LastResult = job.Status.Phase
+		s.NextRun = next
+	})
+}
+
+// createImageJob creates a collector ImageJob. A job for nodes under disk
+// pressure only runs on the given nodes, and does not count as a run of the
+// schedule.
+func (r *Reconciler) createImageJob(ctx context.Context, nodes []string) (ctrl.Result, error) {
 	eraserConfig, err := r.eraserConfig.Read()
 	if err != nil {
 		return ctrl.Result{}, err
@@ -314,9 +508,35 @@ func (r *Reconciler) createImageJob(ctx context.Context) (ctrl.Result, error) {
 
 	collArgs := []string{"--scan-disabled=" + strconv.FormatBool(scanDisabled)}
 	collArgs = append(collArgs, profileArgs...)
+	if unusedFor := mgrCfg.CollectorPolicy.UnusedFor; unusedFor > 0 {
+		collArgs = append(collArgs, "--unused-for="+time.Duration(unusedFor).String())
+	}
+	if keepLast := mgrCfg.CollectorPolicy.KeepLast; keepLast > 0 {
+		collArgs = append(collArgs, fmt.Sprintf("--keep-last=%d", keepLast))
+	}
+
+	diskUsage := mgrCfg.CollectorPolicy.DiskUsage
+	if diskUsage.HighThresholdPercent > 0 {
+		collArgs = append(collArgs, fmt.Sprintf("--imagefs-high-threshold=%d", diskUsage.HighThresholdPercent))
+	}
 
 	removerArgs := []string{"--log-level=" + logger.GetLevel()}
 	removerArgs = append(removerArgs, profileArgs...)
+	removerArgs = append(removerArgs, util.RemoverArgs(&eraserCfg)...)
+	if mgrCfg.DryRun {
+		removerArgs = append(removerArgs, "--dry-run")
+	}
+	if diskUsage.HighThresholdPercent > 0 {
+		removerArgs = append(removerArgs,
+			fmt.Sprintf("--imagefs-high-threshold=%d", diskUsage.HighThresholdPercent),
+			fmt.Sprintf("--imagefs-low-threshold=%d", diskUsage.LowThresholdPercent),
+		)
+	}
+
+	configMapName, err := r.scannerConfigMap(ctx, &eraserConfig)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 
 	pullSecrets := []corev1.LocalObjectReference{}
 	for _, secret := range eraserConfig.Manager.PullSecrets {
@@ -330,12 +550,21 @@ func (r *Reconciler) createImageJob(ctx context.Context) (ctrl.Result, error) {
 					// EmptyDir default
 					Name: "shared-data",
 				},
+				{
+					Name: imageUsageVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						HostPath: &corev1.HostPathVolumeSource{
+							Path: eraserUtils.ImageUsageDir,
+							Type: &hostPathDirectoryOrCreate,
+						},
+					},
+				},
 				{
 					Name: configVolumeName,
 					VolumeSource: corev1.VolumeSource{
 						ConfigMap: &corev1.ConfigMapVolumeSource{
 							LocalObjectReference: corev1.LocalObjectReference{
-								Name: util.EraserConfigmapName,
+								Name: configMapName,
 							},
 						},
 					},
@@ -352,6 +581,7 @@ func (r *Reconciler) createImageJob(ctx context.Context) (ctrl.Result, error) {
 					Args:            collArgs,
 					VolumeMounts: []corev1.VolumeMount{
 						{MountPath: "/run/eraser.sh/shared-data", Name: "shared-data"},
+						{MountPath: eraserUtils.ImageUsageDir, Name: imageUsageVolumeName},
 					},
 					Resources: corev1.ResourceRequirements{
 						Requests: corev1.ResourceList{
@@ -397,6 +627,21 @@ func (r *Reconciler) createImageJob(ctx context.Context) (ctrl.Result, error) {
 		},
 	}
 
+	if diskUsage.HighThresholdPercent > 0 {
+		// the collector and remover measure the filesystem the directory is on
+		jobTemplate.Spec.Volumes = append(jobTemplate.Spec.Volumes, corev1.Volume{
+			Name: imageFsVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{Path: imageFsPath(&mgrCfg)},
+			},
+		})
+		for i := range jobTemplate.Spec.Containers {
+			jobTemplate.Spec.Containers[i].VolumeMounts = append(jobTemplate.Spec.Containers[i].VolumeMounts,
+				corev1.VolumeMount{MountPath: eraserUtils.ImageFsMountPath, Name: imageFsVolumeName, ReadOnly: true},
+			)
+		}
+	}
+
 	job := &eraserv1alpha1.ImageJob{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName: "imagejob-",
@@ -405,6 +650,11 @@ func (r *Reconciler) createImageJob(ctx context.Context) (ctrl.Result, error) {
 			},
 		},
 	}
+	if len(nodes) > 0 {
+		job.Annotations = map[string]string{
+			util.ImageJobNodesAnnotationKey: strings.Join(nodes, ","),
+		}
+	}
 
 	if !scanDisabled {
 		iCfg := scanCfg.Image
@@ -416,12 +666,14 @@ func (r *Reconciler) createImageJob(ctx context.Context) (ctrl.Result, error) {
 		scannerArgs = append(scannerArgs, profileArgs...)
 
 		scannerContainer := corev1.Container{
-			Name:  "trivy-scanner",
+			Name:  util.ScannerContainerName,
 			Image: scannerImg,
 			Args:  scannerArgs,
 			VolumeMounts: []corev1.VolumeMount{
 				{MountPath: "/run/eraser.sh/shared-data", Name: "shared-data"},
 				{MountPath: cfgDirname, Name: configVolumeName},
+				// the verdicts of previous scans are kept on the host
+				{MountPath: eraserUtils.ImageUsageDir, Name: imageUsageVolumeName},
 			},
 			Resources: corev1.ResourceRequirements{
 				Requests: corev1.ResourceList{
@@ -446,6 +698,27 @@ func (r *Reconciler) createImageJob(ctx context.Context) (ctrl.Result, error) {
 					Name:  "ERASER_RUNTIME_NAME",
 					Value: string(mgrCfg.Runtime.Name),
 				},
+				// the limits bound the number of images scanned at once.
+				// Without a limit, the allocatable resources of the node
+				// are given.
+				{
+					Name: eraserUtils.EnvScannerCPULimit,
+					ValueFrom: &corev1.EnvVarSource{
+						ResourceFieldRef: &corev1.ResourceFieldSelector{
+							ContainerName: util.ScannerContainerName,
+							Resource:      "limits.cpu",
+						},
+					},
+				},
+				{
+					Name: eraserUtils.EnvScannerMemoryLimit,
+					ValueFrom: &corev1.EnvVarSource{
+						ResourceFieldRef: &corev1.ResourceFieldSelector{
+							ContainerName: util.ScannerContainerName,
+							Resource:      "limits.memory",
+						},
+					},
+				},
 			},
 		}
 
@@ -472,40 +745,30 @@ func (r *Reconciler) createImageJob(ctx context.Context) (ctrl.Result, error) {
 		jobTemplate.Spec.Containers = append(jobTemplate.Spec.Containers, scannerContainer)
 	}
 
-	configmapList := &corev1.ConfigMapList{}
-	if err := r.List(ctx, configmapList, client.InNamespace(eraserUtils.GetNamespace())); err != nil {
-		log.Info("Could not get list of configmaps")
+	err = r.Create(ctx, job)
+	if err != nil {
+		log.Info("Could not create collector ImageJob")
 		return reconcile.Result{}, err
 	}
 
-	exclusionMount, exclusionVolume, err := util.GetExclusionVolume(configmapList)
+	exclusionMount, exclusionVolume, err := util.ExclusionVolume(ctx, r.Client, r.recorder, job)
 	if err != nil {
 		log.Info("Could not get exclusion mounts and volumes")
 		return reconcile.Result{}, err
 	}
 
 	for i := range jobTemplate.Spec.Containers {
-		jobTemplate.Spec.Containers[i].VolumeMounts = append(jobTemplate.Spec.Containers[i].VolumeMounts, exclusionMount...)
+		jobTemplate.Spec.Containers[i].VolumeMounts = append(jobTemplate.Spec.Containers[i].VolumeMounts, exclusionMount)
 	}
 
-	jobTemplate.Spec.Volumes = append(jobTemplate.Spec.Volumes, exclusionVolume...)
+	jobTemplate.Spec.Volumes = append(jobTemplate.Spec.Volumes, exclusionVolume)
 
-	err = r.Create(ctx, job)
+	// the manager pod owns the pod template
+	managerPod, err := util.ManagerPod(ctx, r)
 	if err != nil {
-		log.Info("Could not create collector ImageJob")
 		return reconcile.Result{}, err
 	}
 
-	// get manager pod with label control-plane=controller-manager
-	podList := corev1.PodList{}
-	if err := r.List(ctx, &podList, client.InNamespace(eraserUtils.GetNamespace()), client.MatchingLabels{"control-plane": "controller-manager"}); err != nil {
-		log.Info("Unable to list controller-manager pod")
-	}
-	if len(podList.Items) != 1 {
-		log.Info("Incorrect number of controller-manager pods", "number of pods", len(podList.Items))
-	}
-	managerPod := &podList.Items[0]
-
 	namespace := eraserUtils.GetNamespace()
 	template := corev1.PodTemplate{
 		ObjectMeta: metav1.ObjectMeta{
@@ -525,9 +788,96 @@ func (r *Reconciler) createImageJob(ctx context.Context) (ctrl.Result, error) {
 	}
 
 	log.Info("Successfully created collector ImageJob", "job", job.Name)
+	if len(nodes) > 0 {
+		r.recorder.Eventf(job, corev1.EventTypeNormal, util.EventReasonJobCreated,
+			"Created collector imagejob for the nodes under disk pressure: %s", strings.Join(nodes, ", "))
+	} else {
+		r.recorder.Event(job, corev1.EventTypeNormal, util.EventReasonJobCreated, "Created collector imagejob on schedule")
+	}
+	if len(nodes) > 0 {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.recordRun(ctx, job.Name); err != nil {
+		log.Error(err, "Could not store the collector schedule", "job", job.Name)
+	}
+
 	return reconcile.Result{}, nil
 }
 
+// imageFsPath returns the host directory on the image filesystem that is
+// mounted to measure its usage.
+func imageFsPath(mgrCfg *unversioned.ManagerConfig) string {
+	if path := mgrCfg.CollectorPolicy.DiskUsage.ImageFsPath; path != "" {
+		return path
+	}
+
+	switch mgrCfg.Runtime.Name {
+	case unversioned.RuntimeCrio:
+		return "/var/lib/containers/storage"
+	case unversioned.RuntimeDockerShim:
+		return "/var/lib/docker"
+	default:
+		return "/var/lib/containerd"
+	}
+}
+
+// reportResults records how many images each ImageExclusion protected on the
+// nodes of the job and logs the disk space reclaimed on each node. For dry
+// runs, it also logs the images the remover pods would have removed on each
+// node.
+func (r *Reconciler) reportResults(ctx context.Context, job *eraserv1.ImageJob, dryRun bool) {
+	nodes, err := util.NodeResults(ctx, r.Client, job)
+	if err != nil {
+		log.Error(err, "could not read results of imagejob", "job", job.Name)
+		return
+	}
+	if nodes == nil {
+		return
+	}
+
+	if err := util.UpdateExclusionStatus(ctx, r.Client, nodes); err != nil {
+		log.Error(err, "could not update the status of the imageexclusions", "job", job.Name)
+	}
+
+	var reclaimed, wouldReclaim int64
+	for _, node := range nodes {
+		reclaimed += node.ReclaimedBytes
+		wouldReclaim += node.WouldReclaimBytes
+		log.Info("disk space reclaimed", "job", job.Name, "node", node.Name,
+			"removed", node.Removed, "reclaimedBytes", node.ReclaimedBytes,
+			"wouldRemove", node.WouldRemove, "wouldReclaimBytes", node.WouldReclaimBytes,
+		)
+
+		if fs := node.ImageFs; fs != nil {
+			log.Info("image filesystem usage", "job", job.Name, "node", node.Name,
+				"capacityBytes", fs.CapacityBytes,
+				"usedBytesBefore", fs.UsedBytesBefore, "usedBytesAfter", fs.UsedBytesAfter,
+				"imageBytesBefore", fs.ImageBytesBefore, "imageBytesAfter", fs.ImageBytesAfter,
+				"skipped", node.Skipped,
+			)
+		}
+	}
+
+	log.Info("disk space reclaimed by imagejob", "job", job.Name, "nodes", len(nodes),
+		"reclaimedBytes", reclaimed, "wouldReclaimBytes", wouldReclaim)
+
+	if !dryRun {
+		return
+	}
+
+	for _, node := range nodes {
+		images := []string{}
+		for _, img := range node.Images {
+			if img.Result == eraserv1.ImageWouldRemove {
+				images = append(images, img.Image)
+			}
+		}
+
+		log.Info("dry run: images that would be removed", "job", job.Name, "node", node.Name, "count", node.WouldRemove, "images", images)
+	}
+}
+
 func (r *Reconciler) handleCompletedImageJob(ctx context.Context, childJob *eraserv1.ImageJob) (ctrl.Result, error) {
 	var err error
 	var timeRemaining time.Duration
@@ -537,12 +887,20 @@ func (r *Reconciler) handleCompletedImageJob(ctx context.Context, childJob *eras
 	}
 
 	otlpEndpoint := eraserConfig.Manager.OTLPEndpoint
-	repeatInterval := time.Duration(eraserConfig.Manager.Scheduling.RepeatInterval)
+
+	sched, err := schedule.New(&eraserConfig.Manager.Scheduling)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 
 	cleanupCfg := eraserConfig.Manager.ImageJob.Cleanup
 	successDelay := time.Duration(cleanupCfg.DelayOnSuccess)
 	errDelay := time.Duration(cleanupCfg.DelayOnFailure)
 
+	if childJob.Status.DeleteAfter == nil {
+		r.reportResults(ctx, childJob, eraserConfig.Manager.DryRun)
+	}
+
 	switch phase := childJob.Status.Phase; phase {
 	case eraserv1.PhaseCompleted:
 		log.Info("completed phase")
@@ -562,10 +920,13 @@ func (r *Reconciler) handleCompletedImageJob(ctx context.Context, childJob *eras
 			metrics.ExportMetrics(log, exporter, reader)
 		}
 
-		timeRemaining = repeatInterval - successDelay
 		if res, err := r.handleJobDeletion(ctx, childJob); err != nil || res.RequeueAfter > 0 {
 			return res, err
 		}
+		if triggeredByDiskPressure(childJob) {
+			return r.resumeSchedule(ctx)
+		}
+		timeRemaining = r.scheduleNext(ctx, sched, childJob, childJob.Status.DeleteAfter.Add(-successDelay))
 	case eraserv1.PhaseFailed:
 		log.Info("failed phase")
 		if childJob.Status.DeleteAfter == nil {
@@ -584,10 +945,13 @@ func (r *Reconciler) handleCompletedImageJob(ctx context.Context, childJob *eras
 			metrics.ExportMetrics(log, exporter, reader)
 		}
 
-		timeRemaining = repeatInterval - errDelay
 		if res, err := r.handleJobDeletion(ctx, childJob); err != nil || res.RequeueAfter > 0 {
 			return res, err
 		}
+		if triggeredByDiskPressure(childJob) {
+			return r.resumeSchedule(ctx)
+		}
+		timeRemaining = r.scheduleNext(ctx, sched, childJob, childJob.Status.DeleteAfter.Add(-errDelay))
 	default:
 		err = errors.New("should not reach this point for imagejob")
 		log.Error(err, "imagejob not in completed or failed phase", "imagejob", childJob)
@@ -599,3 +963,21 @@ func (r *Reconciler) handleCompletedImageJob(ctx context.Context, childJob *eras
 
 	return ctrl.Result{RequeueAfter: timeRemaining}, err
 }
+
+// scheduleNext stores the time of the collector ImageJob following job,
+// which completed at completed, and returns the time until it should start.
+func (r *Reconciler) scheduleNext(ctx context.Context, sched *schedule.Schedule, job *eraserv1.ImageJob, completed time.Time) time.Duration {
+	now := time.Now()
+	next := sched.Next(completed, now)
+	if next.IsZero() {
+		log.Error(fmt.Errorf("the collector schedule has no run outside of the blackout windows"), "unable to schedule the next collector imagejob")
+		return 0
+	}
+
+	if err := r.recordCompletion(ctx, job, completed, next); err != nil {
+		log.Error(err, "Could not store the collector schedule", "job", job.Name)
+	}
+
+	log.Info("Next collector imagejob scheduled", "time", next)
+	return next.Sub(now)
+}
@@ -0,0 +1,140 @@
+package imagecollector
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	eraserv1 "github.com/eraser-dev/eraser/api/v1"
+	eraserUtils "github.com/eraser-dev/eraser/pkg/utils"
+)
+
+const (
+	stateConfigMapName = "eraser-collector-state"
+
+	stateLastRun       = "lastRun"
+	stateLastJob       = "lastJob"
+	stateLastCompleted = "lastCompleted"
+	stateLastResult    = "lastResult"
+	stateNextRun       = "nextRun"
+	stateTriggered     = "diskPressureTriggered"
+)
+
+// scheduleState is the state of the collector schedule. It is kept in a
+// configmap so that it survives restarts of the manager.
+type scheduleState struct {
+	// when the last collector ImageJob was created, and its name
+	LastRun time.Time
+	LastJob string
+	// when the last collector ImageJob completed, and its phase
+	LastCompleted time.Time
+	LastResult    eraserv1.JobPhase
+	// when the next collector ImageJob is due. It is zero while a job is
+	// running.
+	NextRun time.Time
+	// when a collector ImageJob was last started for each node under disk
+	// pressure
+	Triggered map[string]time.Time
+}
+
+func stateKey() types.NamespacedName {
+	return types.NamespacedName{Namespace: eraserUtils.GetNamespace(), Name: stateConfigMapName}
+}
+
+func (r *Reconciler) readState(ctx context.Context) (scheduleState, error) {
+	cm := corev1.ConfigMap{}
+	if err := r.apiReader.Get(ctx, stateKey(), &cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return scheduleState{}, nil
+		}
+		return scheduleState{}, err
+	}
+
+	return scheduleState{
+		LastRun:       parseStateTime(cm.Data[stateLastRun]),
+		LastJob:       cm.Data[stateLastJob],
+		LastCompleted: parseStateTime(cm.Data[stateLastCompleted]),
+		LastResult:    eraserv1.JobPhase(cm.Data[stateLastResult]),
+		NextRun:       parseStateTime(cm.Data[stateNextRun]),
+		Triggered:     parseStateTriggered(cm.Data[stateTriggered]),
+	}, nil
+}
+
+// updateState applies update to the stored state.
+func (r *Reconciler) updateState(ctx context.Context, update func(*scheduleState)) error {
+	state, err := r.readState(ctx)
+	if err != nil {
+		return err
+	}
+
+	update(&state)
+
+	data := map[string]string{
+		stateLastRun:       formatStateTime(state.LastRun),
+		stateLastJob:       state.LastJob,
+		stateLastCompleted: formatStateTime(state.LastCompleted),
+		stateLastResult:    string(state.LastResult),
+		stateNextRun:       formatStateTime(state.NextRun),
+	}
+	if len(state.Triggered) > 0 {
+		b, err := json.Marshal(state.Triggered)
+		if err != nil {
+			return err
+		}
+		data[stateTriggered] = string(b)
+	}
+
+	cm := corev1.ConfigMap{}
+	err = r.apiReader.Get(ctx, stateKey(), &cm)
+	if apierrors.IsNotFound(err) {
+		cm = corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      stateConfigMapName,
+				Namespace: eraserUtils.GetNamespace(),
+			},
+			Data: data,
+		}
+		return r.Create(ctx, &cm)
+	}
+	if err != nil {
+		return err
+	}
+
+	cm.Data = data
+	return r.Update(ctx, &cm)
+}
+
+func parseStateTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
+
+func parseStateTriggered(s string) map[string]time.Time {
+	triggered := map[string]time.Time{}
+	if s == "" {
+		return triggered
+	}
+
+	if err := json.Unmarshal([]byte(s), &triggered); err != nil {
+		return map[string]time.Time{}
+	}
+
+	return triggered
+}
+
+func formatStateTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -44,6 +45,7 @@ import (
 	"github.com/eraser-dev/eraser/api/unversioned/config"
 	eraserv1 "github.com/eraser-dev/eraser/api/v1"
 	controllerUtils "github.com/eraser-dev/eraser/controllers/util"
+	"github.com/eraser-dev/eraser/pkg/metrics"
 	eraserUtils "github.com/eraser-dev/eraser/pkg/utils"
 )
 
@@ -56,6 +58,9 @@ const (
 	removerContainer     = "remover"
 	managerLabelValue    = "controller-manager"
 	managerLabelKey      = "control-plane"
+
+	// how long pods may stay pending before the job is marked as degraded
+	podPendingTimeout = 5 * time.Minute
 )
 
 var log = logf.Log.WithName("controller").WithValues("process", "imagejob-controller")
@@ -75,6 +80,7 @@ func newReconciler(mgr manager.Manager, cfg *config.Manager) reconcile.Reconcile
 	rec := &Reconciler{
 		Client:       mgr.GetClient(),
 		scheme:       mgr.GetScheme(),
+		recorder:     mgr.GetEventRecorderFor("imagejob-controller"),
 		eraserConfig: cfg,
 	}
 
@@ -85,6 +91,7 @@ func newReconciler(mgr manager.Manager, cfg *config.Manager) reconcile.Reconcile
 type Reconciler struct {
 	client.Client
 	scheme       *runtime.Scheme
+	recorder     record.EventRecorder
 	eraserConfig *config.Manager
 }
 
@@ -164,6 +171,7 @@ func add(mgr manager.Manager, r reconcile.Reconciler) error {
 //+kubebuilder:rbac:groups=eraser.sh,resources=imagejobs,verbs=get;list;watch;create;delete
 //+kubebuilder:rbac:groups="",namespace="system",resources=podtemplates,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=eraser.sh,resources=imagejobs/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 //+kubebuilder:rbac:groups="",namespace="system",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -233,7 +241,10 @@ func (r *Reconciler) handleRunningJob(ctx context.Context, imageJob *eraserv1.Im
 		imageJob.Status = eraserv1.ImageJobStatus{
 			Phase:       eraserv1.PhaseFailed,
 			DeleteAfter: controllerUtils.After(time.Now(), 1),
+			Conditions:  imageJob.Status.Conditions,
 		}
+		controllerUtils.MarkFailed(&imageJob.Status.Conditions, imageJob.Generation, controllerUtils.ConditionReasonPodTemplateMissing,
+			fmt.Sprintf("could not get pod template %s/%s: %v", namespace, imageJob.Name, err))
 		return r.updateJobStatus(ctx, imageJob)
 	}
 
@@ -243,30 +254,65 @@ func (r *Reconciler) handleRunningJob(ctx context.Context, imageJob *eraserv1.Im
 		return err
 	}
 
+	// pods of other jobs of the same type may still be around, e.g. while
+	// their deletion is delayed, so only consider the ones created for this job
+	pods := make([]corev1.Pod, 0, len(podList.Items))
+	for i := range podList.Items {
+		if metav1.IsControlledBy(&podList.Items[i], &template) {
+			pods = append(pods, podList.Items[i])
+		}
+	}
+
 	failed := 0
 	success := 0
 	skipped := imageJob.Status.Skipped
 
-	if !podsComplete(podList.Items) {
+	if !podsComplete(pods) {
 		return nil
 	}
 
 	// if all pods are complete, job is complete
 	// get status of pods
-	for i := range podList.Items {
-		if podList.Items[i].Status.Phase == corev1.PodSucceeded {
+	var reclaimed int64
+	var removals []*eraserv1.NodeResult
+	scans := map[string]*eraserUtils.ScanResult{}
+	for i := range pods {
+		nodeName := pods[i].Spec.NodeName
+		if pods[i].Status.Phase == corev1.PodSucceeded {
 			success++
 		} else {
 			failed++
+			message := fmt.Sprintf("Pod %s of imagejob %s failed: %s", pods[i].Name, imageJob.Name, podFailure(&pods[i]))
+			r.recorder.Eventf(imageJob, corev1.EventTypeWarning, controllerUtils.EventReasonNodeFailed, "Node %s: %s", nodeName, message)
+			r.recorder.Event(controllerUtils.NodeReference(nodeName), corev1.EventTypeWarning, controllerUtils.EventReasonNodeFailed, message)
+		}
+
+		// the removal and scan results are read independently, so that one
+		// that cannot be read does not lose the other.
+		if result, err := controllerUtils.NodeResultFromPod(&pods[i]); err != nil {
+			log.Error(err, "could not read result of pod", "pod", pods[i].Name)
+		} else if result != nil {
+			reclaimed += result.ReclaimedBytes
+			removals = append(removals, result)
+			r.recorder.Event(controllerUtils.NodeReference(nodeName), corev1.EventTypeNormal, controllerUtils.EventReasonImagesRemoved,
+				controllerUtils.RemovalSummary(imageJob.Name, result))
+		}
+
+		if scan, err := controllerUtils.ScanResultFromPod(&pods[i]); err != nil {
+			log.Error(err, "could not read scan result of pod", "pod", pods[i].Name)
+		} else if scan != nil {
+			scans[nodeName] = scan
 		}
 	}
 
 	imageJob.Status = eraserv1.ImageJobStatus{
-		Desired:   imageJob.Status.Desired,
-		Succeeded: success,
-		Skipped:   skipped,
-		Failed:    failed,
-		Phase:     eraserv1.PhaseCompleted,
+		Desired:        imageJob.Status.Desired,
+		Succeeded:      success,
+		Skipped:        skipped,
+		Failed:         failed,
+		ReclaimedBytes: reclaimed,
+		Phase:          eraserv1.PhaseCompleted,
+		Conditions:     imageJob.Status.Conditions,
 	}
 
 	successAndSkipped := success + skipped
@@ -279,23 +325,65 @@ func (r *Reconciler) handleRunningJob(ctx context.Context, imageJob *eraserv1.Im
 	managerConfig := eraserConfig.Manager
 	successRatio := managerConfig.ImageJob.SuccessRatio
 
-	if float64(successAndSkipped/imageJob.Status.Desired) < successRatio {
-		log.Info(
-			"Marking job as failed",
-			"success ratio", successRatio,
-			"actual ratio", success/imageJob.Status.Desired,
-		)
-		imageJob.Status.Phase = eraserv1.PhaseFailed
+	desired := imageJob.Status.Desired
+	if desired > 0 {
+		if ratio := float64(successAndSkipped) / float64(desired); ratio < successRatio {
+			log.Info(
+				"Marking job as failed",
+				"success ratio", successRatio,
+				"actual ratio", ratio,
+			)
+			imageJob.Status.Phase = eraserv1.PhaseFailed
+		}
+	}
+
+	switch {
+	case imageJob.Status.Phase == eraserv1.PhaseFailed:
+		message := fmt.Sprintf("%s, below the success ratio of %v", controllerUtils.JobSummary(imageJob), successRatio)
+		controllerUtils.MarkFailed(&imageJob.Status.Conditions, imageJob.Generation, controllerUtils.ConditionReasonSuccessRatioNotMet, message)
+		r.recorder.Event(imageJob, corev1.EventTypeWarning, controllerUtils.EventReasonJobFailed, message)
+	case desired == 0:
+		// the nodes a job was started for may be gone by the time it starts,
+		// which leaves nothing to do
+		message := "No nodes to run on"
+		log.Info("no nodes to run the job on, marking it as completed", "job", imageJob.Name)
+		controllerUtils.MarkSucceeded(&imageJob.Status.Conditions, imageJob.Generation, controllerUtils.ConditionReasonNoNodes, message)
+		r.recorder.Event(imageJob, corev1.EventTypeNormal, controllerUtils.EventReasonJobCompleted, message)
+	default:
+		controllerUtils.MarkSucceeded(&imageJob.Status.Conditions, imageJob.Generation, controllerUtils.ConditionReasonSucceeded, controllerUtils.JobSummary(imageJob))
+		r.recorder.Event(imageJob, corev1.EventTypeNormal, controllerUtils.EventReasonJobCompleted, controllerUtils.JobSummary(imageJob))
 	}
 
-	return r.updateJobStatus(ctx, imageJob)
+	if err := r.updateJobStatus(ctx, imageJob); err != nil {
+		return err
+	}
+
+	// the metrics are kept once the outcome is stored, so that a retry does
+	// not count the job twice
+	jobType := collectorJobType
+	if template.Template.Spec.Containers[0].Name == removerContainer {
+		jobType = manualJobType
+	}
+	metrics.ObserveImageJob(jobType, string(imageJob.Status.Phase), time.Since(imageJob.CreationTimestamp.Time), success, failed)
+	for _, result := range removals {
+		metrics.ObserveRemoval(result.Removed, result.ReclaimedBytes)
+	}
+	for _, scan := range scans {
+		metrics.ObserveScan(scan.Vulnerable, scan.Failed)
+	}
+
+	return nil
 }
 
 func (r *Reconciler) handleNewJob(ctx context.Context, imageJob *eraserv1.ImageJob) error {
 	nodes := &corev1.NodeList{}
 	err := r.List(ctx, nodes)
 	if err != nil {
-		return err
+		return r.nodeListFailed(ctx, imageJob, err)
+	}
+
+	if names, ok := imageJob.Annotations[controllerUtils.ImageJobNodesAnnotationKey]; ok {
+		nodes.Items = scopeToNodes(nodes.Items, strings.Split(names, ","))
 	}
 
 	template := corev1.PodTemplate{}
@@ -311,11 +399,12 @@ func (r *Reconciler) handleNewJob(ctx context.Context, imageJob *eraserv1.ImageJ
 	}
 
 	imageJob.Status = eraserv1.ImageJobStatus{
-		Desired:   len(nodes.Items),
-		Succeeded: 0,
-		Skipped:   0, // placeholder, updated below
-		Failed:    0,
-		Phase:     eraserv1.PhaseRunning,
+		Desired:    len(nodes.Items),
+		Succeeded:  0,
+		Skipped:    0, // placeholder, updated below
+		Failed:     0,
+		Phase:      eraserv1.PhaseRunning,
+		Conditions: imageJob.Status.Conditions,
 	}
 
 	skipped := 0
@@ -341,19 +430,20 @@ func (r *Reconciler) handleNewJob(ctx context.Context, imageJob *eraserv1.ImageJ
 	switch filterOpts.Type {
 	case "exclude":
 		nodeList, skipped, err = filterOutSkippedNodes(nodes, filterOpts.Selectors)
-		if err != nil {
-			return err
-		}
 	case "include":
 		nodeList, skipped, err = selectIncludedNodes(nodes, filterOpts.Selectors)
-		if err != nil {
-			return err
-		}
 	default:
-		return errors.Errorf("invalid node filter option")
+		err = errors.Errorf("invalid node filter option")
+	}
+	if err != nil {
+		// the job stays new, to be started again
+		imageJob.Status = eraserv1.ImageJobStatus{Conditions: imageJob.Status.Conditions}
+		return r.nodeListFailed(ctx, imageJob, err)
 	}
 
 	imageJob.Status.Skipped = skipped
+	controllerUtils.MarkProgressing(&imageJob.Status.Conditions, imageJob.Generation, controllerUtils.ConditionReasonPodsStarted,
+		fmt.Sprintf("Starting pods on %d nodes, %d nodes skipped", len(nodeList), skipped))
 	if err := r.updateJobStatus(ctx, imageJob); err != nil {
 		return err
 	}
@@ -403,16 +493,41 @@ func (r *Reconciler) handleNewJob(ctx context.Context, imageJob *eraserv1.ImageJ
 		namespacedNames = append(namespacedNames, types.NamespacedName{Name: pod.Name, Namespace: pod.Namespace})
 	}
 
+	var pending []string
 	for _, namespacedName := range namespacedNames {
 		//nolint:staticcheck // SA1019: TODO: Replace with PollUntilContextTimeout in future refactor
-		if err := wait.PollImmediate(time.Nanosecond, time.Minute*5, r.isPodReady(ctx, namespacedName)); err != nil {
+		if err := wait.PollImmediate(time.Nanosecond, podPendingTimeout, r.isPodReady(ctx, namespacedName)); err != nil {
 			log.Error(err, "timed out waiting for pod to leave pending state", "pod NamespacedName", namespacedName)
+			pending = append(pending, namespacedName.Name)
 		}
 	}
 
+	if len(pending) > 0 {
+		controllerUtils.MarkDegraded(&imageJob.Status.Conditions, imageJob.Generation, controllerUtils.ConditionReasonPodsPending,
+			fmt.Sprintf("Pods did not leave the pending state within %v: %s", podPendingTimeout, strings.Join(pending, ", ")))
+		if err := r.updateJobStatus(ctx, imageJob); err != nil {
+			log.Error(err, "could not update the conditions of the imagejob")
+		}
+	}
+
+	r.recorder.Eventf(imageJob, corev1.EventTypeNormal, controllerUtils.EventReasonJobStarted,
+		"Started pods on %d nodes, %d nodes skipped", len(namespacedNames), skipped)
+
 	return nil
 }
 
+// nodeListFailed records that the nodes to run the job on could not be
+// determined, and returns err so that the job is retried.
+func (r *Reconciler) nodeListFailed(ctx context.Context, imageJob *eraserv1.ImageJob, err error) error {
+	controllerUtils.MarkDegraded(&imageJob.Status.Conditions, imageJob.Generation, controllerUtils.ConditionReasonNodeListFailed,
+		fmt.Sprintf("Could not determine the nodes to run on: %v", err))
+	if updateErr := r.updateJobStatus(ctx, imageJob); updateErr != nil {
+		log.Error(updateErr, "could not update the conditions of the imagejob", "job", imageJob.Name)
+	}
+
+	return err
+}
+
 func (r *Reconciler) isPodReady(ctx context.Context, namespacedName types.NamespacedName) wait.ConditionFunc {
 	return func() (bool, error) {
 		currentPod := &corev1.Pod{}
@@ -452,6 +567,30 @@ func containersFailed(pod *corev1.Pod) bool {
 	return false
 }
 
+// podFailure describes why the pod failed, from the first container that
+// exited with an error.
+func podFailure(pod *corev1.Pod) string {
+	statuses := pod.Status.ContainerStatuses
+	for i := range statuses {
+		terminated := statuses[i].State.Terminated
+		if terminated == nil || terminated.ExitCode == 0 {
+			continue
+		}
+
+		message := fmt.Sprintf("container %s exited with code %d", statuses[i].Name, terminated.ExitCode)
+		if terminated.Reason != "" {
+			message += " (" + terminated.Reason + ")"
+		}
+		return message
+	}
+
+	if pod.Status.Message != "" {
+		return pod.Status.Message
+	}
+
+	return "pod phase " + string(pod.Status.Phase)
+}
+
 func (r *Reconciler) updateJobStatus(ctx context.Context, imageJob *eraserv1.ImageJob) error {
 	if imageJob.Name != "" {
 		if err := r.Status().Update(ctx, imageJob); err != nil {
@@ -461,6 +600,18 @@ func (r *Reconciler) updateJobStatus(ctx context.Context, imageJob *eraserv1.Ima
 	return nil
 }
 
+// scopeToNodes returns the nodes with the given names.
+func scopeToNodes(nodes []corev1.Node, names []string) []corev1.Node {
+	scoped := make([]corev1.Node, 0, len(names))
+	for i := range nodes {
+		if slices.Contains(names, nodes[i].Name) {
+			scoped = append(scoped, nodes[i])
+		}
+	}
+
+	return scoped
+}
+
 func selectIncludedNodes(nodes *corev1.NodeList, includeNodesSelectors []string) ([]corev1.Node, int, error) {
 	skipped := 0
 	nodeList := make([]corev1.Node, 0, len(nodes.Items))
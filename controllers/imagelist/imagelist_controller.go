@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -46,6 +47,7 @@ import (
 	"github.com/eraser-dev/eraser/controllers/util"
 	"github.com/eraser-dev/eraser/pkg/logger"
 	"github.com/eraser-dev/eraser/pkg/metrics"
+	"github.com/eraser-dev/eraser/pkg/schedule"
 	eraserUtils "github.com/eraser-dev/eraser/pkg/utils"
 
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
@@ -58,7 +60,6 @@ const (
 
 var (
 	log        = logf.Log.WithName("controller").WithValues("process", "imagelist-controller")
-	imageList  = types.NamespacedName{Name: "imagelist"}
 	ownerLabel labels.Selector
 	startTime  time.Time
 	exporter   sdkmetric.Exporter
@@ -102,7 +103,9 @@ func newReconciler(mgr manager.Manager, cfg *config.Manager) (reconcile.Reconcil
 
 	rec := &Reconciler{
 		Client:       mgr.GetClient(),
+		apiReader:    mgr.GetAPIReader(),
 		scheme:       mgr.GetScheme(),
+		recorder:     mgr.GetEventRecorderFor("imagelist-controller"),
 		eraserConfig: cfg,
 	}
 
@@ -117,13 +120,17 @@ type ImageJobReconciler struct {
 // ImageListReconciler reconciles a ImageList object.
 type Reconciler struct {
 	client.Client
+	apiReader    client.Reader
 	scheme       *runtime.Scheme
+	recorder     record.EventRecorder
 	eraserConfig *config.Manager
 }
 
 //+kubebuilder:rbac:groups=eraser.sh,resources=imagelists,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",namespace="system",resources=podtemplates,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=eraser.sh,resources=imagelists/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=eraser.sh,resources=imageexclusions,verbs=get;list;watch
+//+kubebuilder:rbac:groups=eraser.sh,resources=imageexclusions/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",namespace="system",resources=pods,verbs=get;list;watch;update;create;delete
 
@@ -137,12 +144,6 @@ type Reconciler struct {
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.8.3/pkg/reconcile
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
-	// Ignore unsupported lists
-	if req.NamespacedName != imageList {
-		log.Info("Ignoring unsupported imagelist name", "name", req.Name)
-		return reconcile.Result{}, nil
-	}
-
 	imageList := eraserv1.ImageList{}
 	err := r.Get(ctx, req.NamespacedName, &imageList)
 	if err != nil {
@@ -159,6 +160,37 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	switch len(items) {
 	case 0:
+		// ImageLists are processed one after another, so that at most one
+		// remover pod per node is started on behalf of ImageLists.
+		busy, err := r.otherJobRunning(ctx, &imageList)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if busy {
+			log.Info("Another imagelist's imagejob is running, delaying", "imagelist", imageList.Name)
+			return ctrl.Result{RequeueAfter: time.Minute}, nil
+		}
+
+		eraserConfig, err := r.eraserConfig.Read()
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		sched, err := schedule.New(&eraserConfig.Manager.Scheduling)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if now := time.Now(); sched.InBlackout(now) {
+			allowed := sched.Allowed(now)
+			if allowed.IsZero() {
+				return ctrl.Result{}, fmt.Errorf("the blackout windows leave no time to run imagejobs")
+			}
+
+			log.Info("In a blackout window, delaying imagejob", "imagelist", imageList.Name, "until", allowed)
+			return ctrl.Result{RequeueAfter: allowed.Sub(now)}, nil
+		}
+
 		return r.handleImageListEvent(ctx, &imageList)
 	case 1:
 		job := items[0]
@@ -176,6 +208,25 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 }
 
+// otherJobRunning reports whether an ImageJob owned by a different ImageList
+// has not yet completed. The API server is queried directly, since a job
+// created by the previous reconcile may not have reached the cache yet.
+func (r *Reconciler) otherJobRunning(ctx context.Context, imageList *eraserv1.ImageList) (bool, error) {
+	jobList := eraserv1.ImageJobList{}
+	if err := r.apiReader.List(ctx, &jobList, client.MatchingLabelsSelector{Selector: ownerLabel}); err != nil {
+		return false, err
+	}
+
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		if !metav1.IsControlledBy(job, imageList) && !util.IsCompletedOrFailed(job.Status.Phase) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (r *Reconciler) handleJobListEvent(ctx context.Context, imageList *eraserv1.ImageList, job *eraserv1.ImageJob) (ctrl.Result, error) {
 	phase := job.Status.Phase
 	if phase == eraserv1.PhaseCompleted || phase == eraserv1.PhaseFailed {
@@ -197,8 +248,12 @@ func (r *Reconciler) handleJobListEvent(ctx context.Context, imageList *eraserv1
 			switch job.Status.Phase {
 			case eraserv1.PhaseCompleted:
 				job.Status.DeleteAfter = util.After(time.Now(), int64(successDelay.Seconds()))
+				r.recorder.Eventf(imageList, corev1.EventTypeNormal, util.EventReasonJobCompleted,
+					"Imagejob %s completed: %s", job.Name, util.JobSummary(job))
 			case eraserv1.PhaseFailed:
 				job.Status.DeleteAfter = util.After(time.Now(), int64(errDelay.Seconds()))
+				r.recorder.Eventf(imageList, corev1.EventTypeWarning, util.EventReasonJobFailed,
+					"Imagejob %s failed: %s", job.Name, util.JobSummary(job))
 			}
 
 			if err := r.Status().Update(ctx, job); err != nil {
@@ -216,13 +271,13 @@ func (r *Reconciler) handleJobListEvent(ctx context.Context, imageList *eraserv1
 			metrics.ExportMetrics(log, exporter, reader)
 		}
 
-		return r.handleJobDeletion(ctx, job)
+		return r.handleJobDeletion(ctx, imageList, job)
 	}
 
 	return ctrl.Result{}, fmt.Errorf("unexpected job phase: '%s'", job.Status.Phase)
 }
 
-func (r *Reconciler) handleJobDeletion(ctx context.Context, job *eraserv1.ImageJob) (ctrl.Result, error) {
+func (r *Reconciler) handleJobDeletion(ctx context.Context, imageList *eraserv1.ImageList, job *eraserv1.ImageJob) (ctrl.Result, error) {
 	until := time.Until(job.Status.DeleteAfter.Time)
 	if until > 0 {
 		log.Info("Delaying imagejob delete", "job", job.Name, "deleteAter", job.Status.DeleteAfter)
@@ -234,6 +289,7 @@ func (r *Reconciler) handleJobDeletion(ctx context.Context, job *eraserv1.ImageJ
 	if err != nil {
 		return ctrl.Result{}, err
 	}
+	r.recorder.Eventf(imageList, corev1.EventTypeNormal, util.EventReasonJobDeleted, "Deleted imagejob %s", job.Name)
 
 	template := corev1.PodTemplate{}
 	if err := r.Get(ctx,
@@ -283,7 +339,17 @@ func (r *Reconciler) handleImageListEvent(ctx context.Context, imageList *eraser
 		return ctrl.Result{}, err
 	}
 
+	if imageList.Spec.DryRun || eraserConfig.Manager.DryRun {
+		args = append(args, "--dry-run")
+	}
+
+	// the newest images of each repository are also kept when pruning
+	if keepLast := eraserConfig.Manager.CollectorPolicy.KeepLast; keepLast > 0 {
+		args = append(args, fmt.Sprintf("--keep-last=%d", keepLast))
+	}
+
 	eraserContainerCfg := eraserConfig.Components.Remover
+	args = append(args, util.RemoverArgs(&eraserContainerCfg)...)
 	imageCfg := eraserContainerCfg.Image
 	image := fmt.Sprintf("%s:%s", imageCfg.Repo, imageCfg.Tag)
 
@@ -353,45 +419,41 @@ func (r *Reconciler) handleImageListEvent(ctx context.Context, imageList *eraser
 		},
 	}
 
-	configmapList := &corev1.ConfigMapList{}
-	if err := r.List(ctx, configmapList); err != nil {
-		log.Info("Could not get list of configmaps")
+	err = r.Create(ctx, job)
+	startTime = time.Now()
+	log.Info("creating imagejob", "job", job.Name)
+
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
 		return reconcile.Result{}, err
 	}
+	r.recorder.Eventf(imageList, corev1.EventTypeNormal, util.EventReasonJobCreated, "Created imagejob %s", job.Name)
 
-	exclusionMount, exclusionVolume, err := util.GetExclusionVolume(configmapList)
+	util.MarkProgressing(&imageList.Status.Conditions, imageList.Generation, util.ConditionReasonJobCreated, "Created imagejob "+job.Name)
+	if err := r.Status().Update(ctx, imageList); err != nil {
+		log.Error(err, "could not update the conditions of the imagelist", "imagelist", imageList.Name)
+	}
+
+	exclusionMount, exclusionVolume, err := util.ExclusionVolume(ctx, r, r.recorder, job)
 	if err != nil {
 		log.Info("Could not get exclusion mounts and volumes")
 		return reconcile.Result{}, err
 	}
 
 	for i := range jobTemplate.Spec.Containers {
-		jobTemplate.Spec.Containers[i].VolumeMounts = append(jobTemplate.Spec.Containers[i].VolumeMounts, exclusionMount...)
+		jobTemplate.Spec.Containers[i].VolumeMounts = append(jobTemplate.Spec.Containers[i].VolumeMounts, exclusionMount)
 	}
 
-	jobTemplate.Spec.Volumes = append(jobTemplate.Spec.Volumes, exclusionVolume...)
-
-	err = r.Create(ctx, job)
-	startTime = time.Now()
-	log.Info("creating imagejob", "job", job.Name)
+	jobTemplate.Spec.Volumes = append(jobTemplate.Spec.Volumes, exclusionVolume)
 
+	// the manager pod owns the pod template
+	managerPod, err := util.ManagerPod(ctx, r)
 	if err != nil {
-		if errors.IsNotFound(err) {
-			return reconcile.Result{}, nil
-		}
 		return reconcile.Result{}, err
 	}
 
-	// get manager pod with label control-plane=controller-manager
-	podList := corev1.PodList{}
-	if err = r.List(ctx, &podList, client.InNamespace(eraserUtils.GetNamespace()), client.MatchingLabels{"control-plane": "controller-manager"}); err != nil {
-		log.Info("Unable to list controller-manager pod")
-	}
-	if len(podList.Items) != 1 {
-		log.Info("Incorrect number of controller-manager pods", "number of pods", len(podList.Items))
-	}
-	managerPod := &podList.Items[0]
-
 	template := corev1.PodTemplate{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      job.GetName(),
@@ -423,9 +485,25 @@ func (r *Reconciler) handleJobCompletion(ctx context.Context, imageList *eraserv
 	imageList.Status.Success = int64(job.Status.Succeeded)
 	imageList.Status.Failed = int64(job.Status.Failed)
 	imageList.Status.Skipped = int64(job.Status.Skipped)
+	imageList.Status.ReclaimedBytes = job.Status.ReclaimedBytes
 	imageList.Status.Timestamp = &now
+	util.CopyJobConditions(&imageList.Status.Conditions, imageList.Generation, job)
+
+	nodes, err := util.NodeResults(ctx, r.Client, job)
+	if err != nil {
+		log.Error(err, "could not read results of imagejob", "job", job.Name)
+	} else if nodes != nil {
+		if err := util.UpdateExclusionStatus(ctx, r.Client, nodes); err != nil {
+			log.Error(err, "could not update the status of the imageexclusions", "job", job.Name)
+		}
+
+		imageList.Status.Nodes, imageList.Status.NodesOmitted = util.LimitNodeResults(nodes)
+		if imageList.Status.NodesOmitted > 0 {
+			log.Info("left nodes out of the status of the imagelist", "job", job.Name, "omitted", imageList.Status.NodesOmitted)
+		}
+	}
 
-	err := r.Status().Update(ctx, imageList)
+	err = r.Status().Update(ctx, imageList)
 	if err != nil {
 		return err
 	}
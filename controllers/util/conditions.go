@@ -0,0 +1,82 @@
+package util
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	eraserv1 "github.com/eraser-dev/eraser/api/v1"
+)
+
+// Reasons of the conditions of ImageJobs and ImageLists.
+const (
+	// ConditionReasonJobCreated is used while the ImageJob of an ImageList
+	// waits for its pods to be started.
+	ConditionReasonJobCreated = "JobCreated"
+	// ConditionReasonPodsStarted is used while the pods of a job run.
+	ConditionReasonPodsStarted = "PodsStarted"
+	// ConditionReasonPodsPending is used when pods of a job did not leave the
+	// pending phase in time.
+	ConditionReasonPodsPending = "PodsPending"
+	// ConditionReasonSucceeded is used when a job met the success ratio.
+	ConditionReasonSucceeded = "Succeeded"
+	// ConditionReasonSuccessRatioNotMet is used when too many pods of a job
+	// failed.
+	ConditionReasonSuccessRatioNotMet = "SuccessRatioNotMet"
+	// ConditionReasonNoNodes is used when a job completed without nodes to
+	// run on, e.g. because the nodes it was started for are gone.
+	ConditionReasonNoNodes = "NoNodes"
+	// ConditionReasonPodTemplateMissing is used when the pod template of a
+	// job is gone.
+	ConditionReasonPodTemplateMissing = "PodTemplateMissing"
+	// ConditionReasonNodeListFailed is used when the nodes to run a job on
+	// could not be listed or filtered. The job is retried.
+	ConditionReasonNodeListFailed = "NodeListFailed"
+	// ConditionReasonInterrupted is used when a job lost its pods because the
+	// manager restarted.
+	ConditionReasonInterrupted = "Interrupted"
+)
+
+// MarkProgressing sets the conditions of a job that runs.
+func MarkProgressing(conditions *[]metav1.Condition, generation int64, reason, message string) {
+	setCondition(conditions, generation, eraserv1.ConditionProgressing, metav1.ConditionTrue, reason, message)
+	setCondition(conditions, generation, eraserv1.ConditionReady, metav1.ConditionFalse, reason, message)
+	setCondition(conditions, generation, eraserv1.ConditionDegraded, metav1.ConditionFalse, reason, message)
+}
+
+// MarkDegraded records a problem that does not stop the job, which is
+// retried or goes on.
+func MarkDegraded(conditions *[]metav1.Condition, generation int64, reason, message string) {
+	setCondition(conditions, generation, eraserv1.ConditionDegraded, metav1.ConditionTrue, reason, message)
+}
+
+// MarkSucceeded sets the conditions of a job that met the success ratio.
+func MarkSucceeded(conditions *[]metav1.Condition, generation int64, reason, message string) {
+	setCondition(conditions, generation, eraserv1.ConditionProgressing, metav1.ConditionFalse, reason, message)
+	setCondition(conditions, generation, eraserv1.ConditionReady, metav1.ConditionTrue, reason, message)
+	setCondition(conditions, generation, eraserv1.ConditionDegraded, metav1.ConditionFalse, reason, message)
+}
+
+// MarkFailed sets the conditions of a job that failed.
+func MarkFailed(conditions *[]metav1.Condition, generation int64, reason, message string) {
+	setCondition(conditions, generation, eraserv1.ConditionProgressing, metav1.ConditionFalse, reason, message)
+	setCondition(conditions, generation, eraserv1.ConditionReady, metav1.ConditionFalse, reason, message)
+	setCondition(conditions, generation, eraserv1.ConditionDegraded, metav1.ConditionTrue, reason, message)
+}
+
+// CopyJobConditions sets the conditions of an ImageList from the ones of its
+// ImageJob.
+func CopyJobConditions(conditions *[]metav1.Condition, generation int64, job *eraserv1.ImageJob) {
+	for _, c := range job.Status.Conditions {
+		setCondition(conditions, generation, c.Type, c.Status, c.Reason, "Imagejob "+job.Name+": "+c.Message)
+	}
+}
+
+func setCondition(conditions *[]metav1.Condition, generation int64, conditionType string, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
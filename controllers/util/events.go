@@ -0,0 +1,65 @@
+package util
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	eraserv1 "github.com/eraser-dev/eraser/api/v1"
+)
+
+// Reasons of the Events recorded by the controllers.
+const (
+	// EventReasonJobCreated is recorded when an ImageJob is created.
+	EventReasonJobCreated = "ImageJobCreated"
+	// EventReasonJobStarted is recorded when the pods of an ImageJob are
+	// started.
+	EventReasonJobStarted = "ImageJobStarted"
+	// EventReasonJobCompleted is recorded when enough nodes of an ImageJob
+	// succeeded to meet the success ratio.
+	EventReasonJobCompleted = "ImageJobCompleted"
+	// EventReasonJobFailed is recorded when an ImageJob did not meet the
+	// success ratio.
+	EventReasonJobFailed = "ImageJobFailed"
+	// EventReasonJobDeleted is recorded when an ImageJob is deleted.
+	EventReasonJobDeleted = "ImageJobDeleted"
+	// EventReasonNodeFailed is recorded when the pod of an ImageJob failed on
+	// a node.
+	EventReasonNodeFailed = "NodeFailed"
+	// EventReasonImagesRemoved summarizes the images removed from a node.
+	EventReasonImagesRemoved = "ImagesRemoved"
+	// EventReasonInvalidExclusion is recorded on an ImageExclusion or
+	// exclusion configmap with an entry that is ignored because it is not
+	// valid.
+	EventReasonInvalidExclusion = "InvalidExclusion"
+)
+
+// NodeReference returns a reference to a node to record Events on. Like the
+// kubelet does, the UID is set to the name of the node, which is how kubectl
+// describe node finds the Events of a node.
+func NodeReference(name string) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		Kind: "Node",
+		Name: name,
+		UID:  types.UID(name),
+	}
+}
+
+// JobSummary describes how many nodes of a completed ImageJob succeeded.
+func JobSummary(job *eraserv1.ImageJob) string {
+	return fmt.Sprintf("%d of %d nodes succeeded, %d failed, %d skipped",
+		job.Status.Succeeded, job.Status.Desired, job.Status.Failed, job.Status.Skipped)
+}
+
+// RemovalSummary describes the images the remover removed from a node, or
+// would have removed in a dry run.
+func RemovalSummary(job string, result *eraserv1.NodeResult) string {
+	if result.WouldRemove > 0 && result.Removed == 0 {
+		return fmt.Sprintf("Dry run of imagejob %s: would remove %d images, reclaiming %d bytes",
+			job, result.WouldRemove, result.WouldReclaimBytes)
+	}
+
+	return fmt.Sprintf("Imagejob %s removed %d images, reclaiming %d bytes; %d failed",
+		job, result.Removed, result.ReclaimedBytes, result.Errors)
+}
@@ -0,0 +1,173 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	eraserv1 "github.com/eraser-dev/eraser/api/v1"
+	"github.com/eraser-dev/eraser/pkg/imagepattern"
+	eraserUtils "github.com/eraser-dev/eraser/pkg/utils"
+)
+
+const (
+	// ExclusionLabelKey marks the configmaps that list excluded images. They
+	// are still read, but ImageExclusion resources replace them.
+	ExclusionLabelKey = "eraser.sh/exclude.list"
+
+	exclusionVolumeName = "exclusions"
+	exclusionMountPath  = "exclude-resolved"
+	exclusionFileName   = "excluded.json"
+)
+
+var log = logf.Log.WithName("controller").WithValues("process", "exclusions")
+
+// ResolveExclusions merges the patterns of all ImageExclusions and of the
+// labeled exclusion configmaps into one sorted list without duplicates.
+// Patterns that are not valid, and configmap files that cannot be parsed, are
+// left out, so that one bad entry does not stop every job; each is logged and
+// recorded as a warning Event on the object it comes from.
+func ResolveExclusions(ctx context.Context, c client.Reader, recorder record.EventRecorder) ([]string, error) {
+	patterns := map[string]struct{}{}
+
+	exclusions := eraserv1.ImageExclusionList{}
+	if err := c.List(ctx, &exclusions); err != nil {
+		return nil, fmt.Errorf("list imageexclusions: %w", err)
+	}
+
+	for i := range exclusions.Items {
+		for _, pattern := range exclusions.Items[i].Spec.Patterns {
+			if _, err := imagepattern.Parse(pattern); err != nil {
+				invalidExclusion(recorder, &exclusions.Items[i], fmt.Sprintf("Ignoring pattern %q: %v", pattern, err))
+				continue
+			}
+			patterns[pattern] = struct{}{}
+		}
+	}
+
+	configMaps := corev1.ConfigMapList{}
+	if err := c.List(ctx, &configMaps,
+		client.InNamespace(eraserUtils.GetNamespace()),
+		client.MatchingLabels{ExclusionLabelKey: "true"},
+	); err != nil {
+		return nil, fmt.Errorf("list exclusion configmaps: %w", err)
+	}
+
+	for i := range configMaps.Items {
+		cm := &configMaps.Items[i]
+		for key, data := range cm.Data {
+			if !strings.HasSuffix(key, ".json") {
+				continue
+			}
+
+			var list eraserUtils.ExclusionList
+			if err := json.Unmarshal([]byte(data), &list); err != nil {
+				invalidExclusion(recorder, cm, fmt.Sprintf("Ignoring %s, which cannot be parsed: %v", key, err))
+				continue
+			}
+
+			for _, pattern := range list.Excluded {
+				if _, err := imagepattern.Parse(pattern); err != nil {
+					invalidExclusion(recorder, cm, fmt.Sprintf("Ignoring pattern %q in %s: %v", pattern, key, err))
+					continue
+				}
+				patterns[pattern] = struct{}{}
+			}
+		}
+	}
+
+	resolved := make([]string, 0, len(patterns))
+	for pattern := range patterns {
+		resolved = append(resolved, pattern)
+	}
+	sort.Strings(resolved)
+
+	return resolved, nil
+}
+
+// invalidExclusion reports an exclusion that is left out of the resolved list.
+func invalidExclusion(recorder record.EventRecorder, obj client.Object, message string) {
+	log.Info("invalid exclusion", "object", client.ObjectKeyFromObject(obj), "message", message)
+	recorder.Event(obj, corev1.EventTypeWarning, EventReasonInvalidExclusion, message)
+}
+
+// ExclusionVolume stores the resolved exclusions in a configmap owned by the
+// job, and returns the volume and mount that hand them to the job's pods.
+func ExclusionVolume(ctx context.Context, c client.Client, recorder record.EventRecorder, job client.Object) (corev1.VolumeMount, corev1.Volume, error) {
+	patterns, err := ResolveExclusions(ctx, c, recorder)
+	if err != nil {
+		return corev1.VolumeMount{}, corev1.Volume{}, err
+	}
+
+	data, err := json.Marshal(eraserUtils.ExclusionList{Excluded: patterns})
+	if err != nil {
+		return corev1.VolumeMount{}, corev1.Volume{}, err
+	}
+
+	configMap := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.GetName() + "-exclusions",
+			Namespace: eraserUtils.GetNamespace(),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(job, eraserv1.GroupVersion.WithKind("ImageJob")),
+			},
+		},
+		Immutable: eraserUtils.BoolPtr(true),
+		Data:      map[string]string{exclusionFileName: string(data)},
+	}
+	if err := c.Create(ctx, &configMap); err != nil {
+		return corev1.VolumeMount{}, corev1.Volume{}, fmt.Errorf("create exclusion configmap: %w", err)
+	}
+
+	mount := corev1.VolumeMount{MountPath: exclusionMountPath, Name: exclusionVolumeName}
+	volume := corev1.Volume{
+		Name: exclusionVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name}},
+		},
+	}
+
+	return mount, volume, nil
+}
+
+// UpdateExclusionStatus records in each ImageExclusion how many images its
+// patterns protected on the nodes of a completed job.
+func UpdateExclusionStatus(ctx context.Context, c client.Client, nodes []eraserv1.NodeResult) error {
+	counts := map[string]int64{}
+	for i := range nodes {
+		for pattern, count := range nodes[i].Exclusions {
+			counts[pattern] += count
+		}
+	}
+
+	exclusions := eraserv1.ImageExclusionList{}
+	if err := c.List(ctx, &exclusions); err != nil {
+		return fmt.Errorf("list imageexclusions: %w", err)
+	}
+
+	now := metav1.Now()
+	for i := range exclusions.Items {
+		exclusion := &exclusions.Items[i]
+
+		var protected int64
+		for _, pattern := range exclusion.Spec.Patterns {
+			protected += counts[pattern]
+		}
+
+		exclusion.Status.ProtectedImages = protected
+		exclusion.Status.LastRun = &now
+		if err := c.Status().Update(ctx, exclusion); err != nil {
+			return fmt.Errorf("update status of imageexclusion %s: %w", exclusion.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -2,21 +2,31 @@
 package util
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
+	"fmt"
 	"os"
+	"sort"
 	"time"
 
+	"github.com/eraser-dev/eraser/api/unversioned"
 	eraserv1 "github.com/eraser-dev/eraser/api/v1"
+	eraserUtils "github.com/eraser-dev/eraser/pkg/utils"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 var (
 	RemoverImage        = flag.String("remover-image", "", "remover image")
 	EraserConfigmapName = "eraser-manager-config"
+
+	resultsLog = logf.Log.WithName("controller").WithValues("process", "results")
 )
 
 func init() {
@@ -28,7 +38,32 @@ func init() {
 const (
 	ImageJobOwnerLabelKey = "eraser.sh/job-owner"
 
-	exclusionLabel = "eraser.sh/exclude.list=true"
+	// ImageJobNodesAnnotationKey restricts an ImageJob to the nodes it
+	// lists, separated by commas.
+	ImageJobNodesAnnotationKey = "eraser.sh/nodes"
+	// ImageFsUsageAnnotationKey is set on nodes to the percentage of their
+	// image filesystem in use.
+	ImageFsUsageAnnotationKey = "eraser.sh/imagefs-usage"
+
+	ManagerPodLabelKey   = "control-plane"
+	ManagerPodLabelValue = "controller-manager"
+
+	// EraserConfigName is the name of the EraserConfig resource that
+	// overrides the configuration from the configmap.
+	EraserConfigName = "eraser-config"
+
+	removerContainerName = "remover"
+	// ScannerContainerName is the name of the scanner container of collector
+	// pods.
+	ScannerContainerName = "trivy-scanner"
+
+	// maxNodeImages is the number of per-image results kept for each node in
+	// the status of an ImageList.
+	maxNodeImages = 10
+	// maxNodeResultsSize bounds the encoded size of the node results in the
+	// status of an ImageList, well below the ~1.5MB the API server accepts
+	// for a whole object.
+	maxNodeResultsSize = 512 * 1024
 
 	EnvVarContainerdNamespaceKey   = "CONTAINERD_NAMESPACE"
 	EnvVarContainerdNamespaceValue = "k8s.io"
@@ -114,27 +149,181 @@ func After(t time.Time, seconds int64) *metav1.Time {
 	return &newT
 }
 
-func GetExclusionVolume(configmapList *corev1.ConfigMapList) ([]corev1.VolumeMount, []corev1.Volume, error) {
-	var exclusionMount []corev1.VolumeMount
-	var exclusionVolume []corev1.Volume
+// RemoverArgs returns the arguments setting how fast the remover deletes
+// images and its deadline.
+func RemoverArgs(cfg *unversioned.RemoverConfig) []string {
+	args := []string{
+		fmt.Sprintf("--concurrency=%d", cfg.Concurrency),
+		fmt.Sprintf("--deletes-per-second=%d", cfg.DeletesPerSecond),
+	}
+	if cfg.Timeout > 0 {
+		args = append(args, "--timeout="+time.Duration(cfg.Timeout).String())
+	}
+
+	return args
+}
+
+// NodeResultFromPod returns the result the remover container of the pod
+// reported in its termination message, or nil if it has not reported one.
+func NodeResultFromPod(pod *corev1.Pod) (*eraserv1.NodeResult, error) {
+	message := terminationMessage(pod, removerContainerName)
+	if message == "" {
+		return nil, nil
+	}
+
+	result := &eraserv1.NodeResult{}
+	if err := json.Unmarshal([]byte(message), result); err != nil {
+		return nil, fmt.Errorf("parse result of pod %s: %w", pod.Name, err)
+	}
+
+	result.Name = pod.Spec.NodeName
+	return result, nil
+}
 
-	selector, err := labels.Parse(exclusionLabel)
+// ScanResultFromPod returns the result the scanner container of the pod
+// reported in its termination message, or nil if it has not reported one.
+func ScanResultFromPod(pod *corev1.Pod) (*eraserUtils.ScanResult, error) {
+	message := terminationMessage(pod, ScannerContainerName)
+	if message == "" {
+		return nil, nil
+	}
+
+	result := &eraserUtils.ScanResult{}
+	if err := json.Unmarshal([]byte(message), result); err != nil {
+		return nil, fmt.Errorf("parse scan result of pod %s: %w", pod.Name, err)
+	}
+
+	return result, nil
+}
+
+// terminationMessage returns the termination message of the named container
+// of the pod, or an empty string if it has not terminated with one.
+func terminationMessage(pod *corev1.Pod, container string) string {
+	for i := range pod.Status.ContainerStatuses {
+		status := pod.Status.ContainerStatuses[i]
+		if status.Name == container && status.State.Terminated != nil {
+			return status.State.Terminated.Message
+		}
+	}
+
+	return ""
+}
+
+// NodeResults collects the results reported by the remover pods of the job,
+// sorted by node name. Pods whose result cannot be read are logged and left
+// out. It returns nil if the pods are no longer available.
+func NodeResults(ctx context.Context, c client.Reader, job *eraserv1.ImageJob) ([]eraserv1.NodeResult, error) {
+	template := corev1.PodTemplate{}
+	if err := c.Get(ctx,
+		types.NamespacedName{
+			Namespace: eraserUtils.GetNamespace(),
+			Name:      job.GetName(),
+		},
+		&template,
+	); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	podList := corev1.PodList{}
+	if err := c.List(ctx, &podList, client.InNamespace(eraserUtils.GetNamespace())); err != nil {
+		return nil, err
+	}
+
+	nodes := []eraserv1.NodeResult{}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if !metav1.IsControlledBy(pod, &template) {
+			continue
+		}
+
+		result, err := NodeResultFromPod(pod)
+		if err != nil {
+			resultsLog.Error(err, "could not read result of pod, leaving its node out", "job", job.GetName(), "pod", pod.Name)
+			continue
+		}
+		if result == nil {
+			continue
+		}
+
+		nodes = append(nodes, *result)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	return nodes, nil
+}
+
+// LimitNodeResults returns the node results to keep in the status of an
+// ImageList, and how many nodes were left out of them. At most maxNodeImages
+// per-image results are kept for each node. If the results are still larger
+// than maxNodeResultsSize, only the counts of each node are kept, and the
+// nodes that do not fit are left out.
+func LimitNodeResults(nodes []eraserv1.NodeResult) ([]eraserv1.NodeResult, int64) {
+	limited := make([]eraserv1.NodeResult, len(nodes))
+	for i := range nodes {
+		limited[i] = nodes[i]
+		if len(limited[i].Images) > maxNodeImages {
+			limited[i].Images = limited[i].Images[:maxNodeImages]
+			limited[i].ImagesTruncated = true
+		}
+	}
+
+	if encodedSize(limited) <= maxNodeResultsSize {
+		return limited, 0
+	}
+
+	for i := range limited {
+		if len(limited[i].Images) > 0 {
+			limited[i].Images = nil
+			limited[i].ImagesTruncated = true
+		}
+		limited[i].Exclusions = nil
+	}
+
+	// the brackets of the list, and a comma between its items
+	size := 1
+	for i := range limited {
+		size += encodedSize(limited[i]) + 1
+		if size > maxNodeResultsSize {
+			return limited[:i], int64(len(limited) - i)
+		}
+	}
+
+	return limited, 0
+}
+
+// encodedSize returns the size of v encoded as JSON.
+func encodedSize(v interface{}) int {
+	data, err := json.Marshal(v)
 	if err != nil {
-		return nil, nil, err
-	}
-
-	for i := range configmapList.Items {
-		cm := configmapList.Items[i]
-		if selector.Matches(labels.Set(cm.Labels)) {
-			exclusionMount = append(exclusionMount, corev1.VolumeMount{MountPath: "exclude-" + cm.Name, Name: cm.Name})
-			exclusionVolume = append(exclusionVolume, corev1.Volume{
-				Name: cm.Name,
-				VolumeSource: corev1.VolumeSource{
-					ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: cm.Name}},
-				},
-			})
+		return 0
+	}
+
+	return len(data)
+}
+
+// ManagerPod returns the pod of the running manager. If the pod name is not
+// known, the first pod labeled as the controller-manager is returned.
+func ManagerPod(ctx context.Context, c client.Reader) (*corev1.Pod, error) {
+	namespace := eraserUtils.GetNamespace()
+
+	if name := eraserUtils.GetPodName(); name != "" {
+		pod := &corev1.Pod{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod); err != nil {
+			return nil, err
 		}
+		return pod, nil
+	}
+
+	podList := corev1.PodList{}
+	if err := c.List(ctx, &podList, client.InNamespace(namespace), client.MatchingLabels{ManagerPodLabelKey: ManagerPodLabelValue}); err != nil {
+		return nil, err
+	}
+	if len(podList.Items) == 0 {
+		return nil, fmt.Errorf("no controller-manager pod found in namespace %s", namespace)
 	}
 
-	return exclusionMount, exclusionVolume, nil
+	return &podList.Items[0], nil
 }
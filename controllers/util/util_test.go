@@ -0,0 +1,125 @@
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	eraserv1 "github.com/eraser-dev/eraser/api/v1"
+	eraserUtils "github.com/eraser-dev/eraser/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNodeResultsSkipsUnreadablePods(t *testing.T) {
+	namespace := eraserUtils.GetNamespace()
+	job := &eraserv1.ImageJob{ObjectMeta: metav1.ObjectMeta{Name: "imagejob-test"}}
+	template := &corev1.PodTemplate{ObjectMeta: metav1.ObjectMeta{
+		Name: job.Name, Namespace: namespace, UID: "template-uid",
+	}}
+
+	pod := func(node, message string) client.Object {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            job.Name + "-" + node,
+				Namespace:       namespace,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(template, corev1.SchemeGroupVersion.WithKind("PodTemplate"))},
+			},
+			Spec: corev1.PodSpec{NodeName: node},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  removerContainerName,
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: message}},
+			}}},
+		}
+	}
+
+	c := fake.NewClientBuilder().WithObjects(
+		template,
+		pod("node-a", `{"removed": 2}`),
+		pod("node-b", "not json"),
+		pod("node-c", `{"removed": 3}`),
+	).Build()
+
+	nodes, err := NodeResults(context.Background(), c, job)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(nodes) != 2 || nodes[0].Name != "node-a" || nodes[0].Removed != 2 || nodes[1].Name != "node-c" || nodes[1].Removed != 3 {
+		t.Errorf("expected the results of node-a and node-c, got %+v", nodes)
+	}
+}
+
+func TestLimitNodeResults(t *testing.T) {
+	testCases := map[string]struct {
+		nodes      int
+		images     int
+		wantImages int
+		omitted    bool
+	}{
+		"few nodes":          {nodes: 3, images: 5, wantImages: 5},
+		"many images":        {nodes: 3, images: 50, wantImages: maxNodeImages},
+		"hundreds of nodes":  {nodes: 500, images: 50, wantImages: 0},
+		"thousands of nodes": {nodes: 5000, images: 50, wantImages: 0, omitted: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			nodes := make([]eraserv1.NodeResult, tc.nodes)
+			for i := range nodes {
+				nodes[i] = eraserv1.NodeResult{
+					Name:       fmt.Sprintf("node-%05d", i),
+					Removed:    int64(tc.images),
+					Exclusions: map[string]int64{},
+				}
+				for j := 0; j < tc.images; j++ {
+					nodes[i].Images = append(nodes[i].Images, eraserv1.ImageResult{
+						Image:  fmt.Sprintf("docker.io/library/image%d:%s", j, strings.Repeat("a", 20)),
+						Result: eraserv1.ImageRemoved,
+					})
+				}
+				for j := 0; j < 20; j++ {
+					nodes[i].Exclusions[fmt.Sprintf("docker.io/library/excluded%d:*", j)] = 1
+				}
+			}
+
+			limited, omitted := LimitNodeResults(nodes)
+
+			data, err := json.Marshal(limited)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data) > maxNodeResultsSize {
+				t.Fatalf("node results are %d bytes, limit is %d", len(data), maxNodeResultsSize)
+			}
+
+			if (omitted > 0) != tc.omitted {
+				t.Errorf("expected nodes to be omitted: %v, got %d omitted", tc.omitted, omitted)
+			}
+			if got := int64(len(limited)) + omitted; got != int64(tc.nodes) {
+				t.Errorf("expected %d nodes kept or omitted, got %d", tc.nodes, got)
+			}
+
+			for i := range limited {
+				if limited[i].Removed != int64(tc.images) {
+					t.Fatalf("expected removed count %d on node %s, got %d", tc.images, limited[i].Name, limited[i].Removed)
+				}
+				if len(limited[i].Images) != tc.wantImages {
+					t.Fatalf("expected %d images on node %s, got %d", tc.wantImages, limited[i].Name, len(limited[i].Images))
+				}
+				if limited[i].ImagesTruncated != (tc.wantImages < tc.images) {
+					t.Fatalf("expected imagesTruncated to be %v on node %s", tc.wantImages < tc.images, limited[i].Name)
+				}
+			}
+
+			// the results passed in are left alone
+			if len(nodes[0].Images) != tc.images || nodes[0].ImagesTruncated {
+				t.Error("expected the node results passed in to be unchanged")
+			}
+		})
+	}
+}
@@ -17,31 +17,33 @@ limitations under the License.
 package main
 
 import (
-	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"net/http"
 	_ "net/http/pprof"
 	"os"
 	"time"
+	_ "time/tzdata"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	"k8s.io/utils/inotify"
-	"sigs.k8s.io/yaml"
 
+	"github.com/open-policy-agent/cert-controller/pkg/rotator"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/conversion"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -49,54 +51,65 @@ import (
 	"github.com/eraser-dev/eraser/api/unversioned/config"
 	eraserv1 "github.com/eraser-dev/eraser/api/v1"
 	eraserv1alpha1 "github.com/eraser-dev/eraser/api/v1alpha1"
-	v1alpha1Config "github.com/eraser-dev/eraser/api/v1alpha1/config"
 	eraserv1alpha2 "github.com/eraser-dev/eraser/api/v1alpha2"
-	v1alpha2Config "github.com/eraser-dev/eraser/api/v1alpha2/config"
 	eraserv1alpha3 "github.com/eraser-dev/eraser/api/v1alpha3"
-	v1alpha3Config "github.com/eraser-dev/eraser/api/v1alpha3/config"
 	"github.com/eraser-dev/eraser/controllers"
 	"github.com/eraser-dev/eraser/pkg/logger"
+	"github.com/eraser-dev/eraser/pkg/metrics"
 	"github.com/eraser-dev/eraser/pkg/utils"
+	"github.com/eraser-dev/eraser/pkg/webhooks"
 	"github.com/eraser-dev/eraser/version"
 	//+kubebuilder:scaffold:imports
 )
 
+const (
+	webhookCertDir     = "/certs"
+	webhookSecretName  = "eraser-webhook-server-cert"
+	webhookServiceName = "eraser-webhook-service"
+	eraserConfigCRD    = "eraserconfigs.eraser.sh"
+	validatingWebhook  = "eraser-validating-webhook-configuration"
+	mutatingWebhook    = "eraser-mutating-webhook-configuration"
+)
+
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
-
-	fromV1alpha1 = eraserv1alpha1.Convert_v1alpha1_EraserConfig_To_unversioned_EraserConfig
-	fromV1alpha2 = eraserv1alpha2.Convert_v1alpha2_EraserConfig_To_unversioned_EraserConfig
-	fromV1alpha3 = eraserv1alpha3.Convert_v1alpha3_EraserConfig_To_unversioned_EraserConfig
 )
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(eraserv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(eraserv1alpha2.AddToScheme(scheme))
+	utilruntime.Must(eraserv1alpha3.AddToScheme(scheme))
 	utilruntime.Must(eraserv1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
-}
 
-type apiVersion struct {
-	APIVersion string `json:"apiVersion"`
+	// eraser metrics are served by the manager's metrics server
+	ctrlmetrics.Registry.MustRegister(metrics.Collectors()...)
 }
 
-type convertFunc[T any] func(*T, *unversioned.EraserConfig, conversion.Scope) error
+// leader election
+//+kubebuilder:rbac:groups="coordination.k8s.io",namespace="system",resources=leases,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",namespace="system",resources=events,verbs=create;patch
 
-func main() {
-	ctx, cancel := context.WithCancel(context.Background())
+// webhook certificate
+//+kubebuilder:rbac:groups="",namespace="system",resources=secrets,verbs=get;list;watch;update
+//+kubebuilder:rbac:groups="apiextensions.k8s.io",resources=customresourcedefinitions,verbs=get;list;watch;update
+//+kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=validatingwebhookconfigurations,verbs=get;list;watch;update
+//+kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=mutatingwebhookconfigurations,verbs=get;list;watch;update
 
-	go func() {
-		<-ctx.Done()
-		os.Exit(1)
-	}()
-
-	var configFile string
+func main() {
+	var (
+		configFile     string
+		leaderElection bool
+	)
 	flag.StringVar(&configFile, "config", "",
 		"The controller will load its initial configuration from this file. "+
 			"Omit this flag to use the default configuration values. "+
 			"Command-line flags override configuration from this file.")
+	flag.BoolVar(&leaderElection, "leader-elect", false,
+		"Enable leader election, so that only one of several manager replicas runs the controllers at a time.")
 	flag.Parse()
 
 	if err := logger.Configure(); err != nil {
@@ -106,11 +119,20 @@ func main() {
 
 	// these can all be overwritten using EraserConfig.
 	options := ctrl.Options{
-		Scheme:                 scheme,
-		Metrics:                server.Options{BindAddress: ":8889"},
-		WebhookServer:          webhook.NewServer(webhook.Options{Port: 9443}),
+		Scheme:  scheme,
+		Metrics: server.Options{BindAddress: ":8889"},
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Port:    9443,
+			CertDir: webhookCertDir,
+		}),
 		HealthProbeBindAddress: ":8081",
-		LeaderElection:         false,
+		// the controllers, including the collector schedule and the
+		// configmap reconciler, only run on the leader. every replica keeps
+		// watching the configuration file.
+		LeaderElection:                leaderElection,
+		LeaderElectionID:              "eraser-manager.eraser.sh",
+		LeaderElectionNamespace:       utils.GetNamespace(),
+		LeaderElectionReleaseOnCancel: true,
 		Cache: cache.Options{
 			ByObject: map[client.Object]cache.ByObject{
 				// to watch eraser pods
@@ -129,6 +151,8 @@ func main() {
 				&eraserv1.ImageJob{}: {},
 				// to watch ImageLists
 				&eraserv1.ImageList{}: {},
+				// to resolve ImageExclusions
+				&eraserv1.ImageExclusion{}: {},
 			},
 		},
 	}
@@ -160,7 +184,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	go startConfigWatch(cancel, watcher, eraserOpts, configFile)
+	go startConfigWatch(watcher, eraserOpts, configFile)
 
 	if managerOpts.Profile.Enabled {
 		go func() {
@@ -184,6 +208,39 @@ func main() {
 		os.Exit(1)
 	}
 
+	// the rotator keeps the webhook certificate in the secret, which is
+	// mounted in webhookCertDir, and injects its CA into the CRD and the
+	// webhook configurations, so that cert-manager is not needed. every
+	// replica serves webhooks, so every replica runs it.
+	certsReady := make(chan struct{})
+	if err := rotator.AddRotator(mgr, &rotator.CertRotator{
+		SecretKey:      types.NamespacedName{Namespace: utils.GetNamespace(), Name: webhookSecretName},
+		CertDir:        webhookCertDir,
+		CAName:         "eraser-ca",
+		CAOrganization: "eraser",
+		DNSName:        fmt.Sprintf("%s.%s.svc", webhookServiceName, utils.GetNamespace()),
+		IsReady:        certsReady,
+		Webhooks: []rotator.WebhookInfo{
+			{Name: eraserConfigCRD, Type: rotator.CRDConversion},
+			{Name: validatingWebhook, Type: rotator.Validating},
+			{Name: mutatingWebhook, Type: rotator.Mutating},
+		},
+		RequireLeaderElection: false,
+	}); err != nil {
+		setupLog.Error(err, "unable to set up webhook certificate rotation")
+		os.Exit(1)
+	}
+
+	// the webhook server only starts once it is registered, which has to wait
+	// for the certificate to be mounted.
+	go func() {
+		<-certsReady
+		if err := webhooks.SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to set up webhooks")
+			os.Exit(1)
+		}
+	}()
+
 	setupLog.Info("setup controllers")
 	if err = controllers.SetupWithManager(mgr, eraserOpts); err != nil {
 		setupLog.Error(err, "unable to setup controllers")
@@ -200,6 +257,17 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if err := mgr.AddReadyzCheck("webhook", func(req *http.Request) error {
+		select {
+		case <-certsReady:
+			return mgr.GetWebhookServer().StartedChecker()(req)
+		default:
+			return errors.New("webhook certificate is not ready")
+		}
+	}); err != nil {
+		setupLog.Error(err, "unable to set up webhook ready check")
+		os.Exit(1)
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -216,39 +284,16 @@ func getConfig(configFile string) (*unversioned.EraserConfig, error) {
 		os.Exit(1)
 	}
 
-	var av apiVersion
-	if err := yaml.Unmarshal(fileBytes, &av); err != nil {
-		setupLog.Error(err, "cannot unmarshal yaml", "bytes", string(fileBytes), "apiVersion", av)
-		os.Exit(1)
-	}
-
-	switch av.APIVersion {
-	case "eraser.sh/v1alpha1":
-		return getUnversioned(fileBytes, v1alpha1Config.Default(), fromV1alpha1)
-	case "eraser.sh/v1alpha2":
-		return getUnversioned(fileBytes, v1alpha2Config.Default(), fromV1alpha2)
-	case "eraser.sh/v1alpha3":
-		return getUnversioned(fileBytes, v1alpha3Config.Default(), fromV1alpha3)
-	default:
-		setupLog.Error(fmt.Errorf("unknown api version"), "error", "apiVersion", av.APIVersion)
-		return nil, err
-	}
-}
-
-func getUnversioned[T any](b []byte, defaults *T, convert convertFunc[T]) (*unversioned.EraserConfig, error) {
-	cfg := defaults
-
-	if err := yaml.Unmarshal(b, cfg); err != nil {
-		setupLog.Error(err, "configuration is either missing or invalid")
+	cfg, err := config.Parse(fileBytes)
+	if err != nil {
 		return nil, err
 	}
 
-	var unv unversioned.EraserConfig
-	if err := convert(cfg, &unv, nil); err != nil {
+	if err := config.Validate(cfg); err != nil {
 		return nil, err
 	}
 
-	return &unv, nil
+	return cfg, nil
 }
 
 // Kubernetes manages configmap volume updates by creating a new file,
@@ -269,7 +314,7 @@ func setupWatcher(configFile string) (*inotify.Watcher, error) {
 	return watcher, nil
 }
 
-func startConfigWatch(cancel context.CancelFunc, watcher *inotify.Watcher, eraserOpts *config.Manager, filename string) {
+func startConfigWatch(watcher *inotify.Watcher, eraserOpts *config.Manager, filename string) {
 	for {
 		select {
 		case ev := <-watcher.Event:
@@ -289,14 +334,6 @@ func startConfigWatch(cancel context.CancelFunc, watcher *inotify.Watcher, erase
 				continue
 			}
 
-			var err error
-			oldConfig := new(unversioned.EraserConfig)
-
-			*oldConfig, err = eraserOpts.Read()
-			if err != nil {
-				setupLog.Error(err, "configuration could not be read", "event", ev, "filename", filename)
-			}
-
 			newConfig, err := getConfig(filename)
 			if err != nil {
 				setupLog.Error(err, "configuration is missing or invalid", "event", ev, "filename", filename)
@@ -315,26 +352,9 @@ func startConfigWatch(cancel context.CancelFunc, watcher *inotify.Watcher, erase
 				continue
 			}
 
-			if needsRestart(oldConfig, newConfig) {
-				setupLog.Info("configurations differ in an irreconcileable way, restarting", "old", oldConfig.Components, "new", newConfig.Components)
-				// restarts the manager
-				cancel()
-			}
-
 			setupLog.V(1).Info("new configuration", "manager", newConfig.Manager, "components", newConfig.Components)
 		case err := <-watcher.Error:
 			setupLog.Error(err, "file watcher error")
 		}
 	}
 }
-
-func needsRestart(oldConfig, newConfig *unversioned.EraserConfig) bool {
-	type check struct {
-		collector bool
-		scanner   bool
-	}
-
-	oldComponents := check{collector: oldConfig.Components.Collector.Enabled, scanner: oldConfig.Components.Scanner.Enabled}
-	newComponents := check{collector: newConfig.Components.Collector.Enabled, scanner: newConfig.Components.Scanner.Enabled}
-	return oldComponents != newComponents
-}
@@ -11,6 +11,8 @@ import (
 	"time"
 
 	"github.com/eraser-dev/eraser/pkg/cri"
+	"github.com/eraser-dev/eraser/pkg/imagepattern"
+	"github.com/eraser-dev/eraser/pkg/lastused"
 	"github.com/eraser-dev/eraser/pkg/logger"
 	"golang.org/x/sys/unix"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -22,11 +24,14 @@ var (
 	enableProfile = flag.Bool("enable-pprof", false, "enable pprof profiling")
 	profilePort   = flag.Int("pprof-port", 6060, "port for pprof profiling. defaulted to 6060 if unspecified")
 	scanDisabled  = flag.Bool("scan-disabled", false, "boolean for if scanner container is disabled")
+	unusedFor     = flag.Duration("unused-for", 0, "only collect images that no container has used for at least this long")
+	keepLast      = flag.Int("keep-last", 0, "keep the most recently created images of each repository that are not running, this many of them. 0 disables the policy")
+	imageFsHigh   = flag.Int("imagefs-high-threshold", 0, "only collect images if the image filesystem is used above this percentage. 0 disables the check")
 
 	// Timeout  of connecting to server (default: 5m).
 	timeout  = 5 * time.Minute
 	log      = logf.Log.WithName("collector")
-	excluded map[string]struct{}
+	excluded *imagepattern.Set
 )
 
 func main() {
@@ -61,18 +66,37 @@ func main() {
 		log.Error(err, "failed to parse exclusion list")
 		os.Exit(1)
 	}
-	if len(excluded) == 0 {
+	if excluded.Len() == 0 {
 		log.Info("no images to exclude")
 	}
 
+	tracker, err := lastused.Load(util.ImageUsagePath)
+	if err != nil {
+		log.Error(err, "failed to load image usage, tracking starts over", "path", util.ImageUsagePath)
+		tracker = lastused.New(util.ImageUsagePath)
+	}
+
 	// finalImages of type []Image
-	finalImages, err := getImages(client)
+	finalImages, exclusionCounts, err := getImages(client, tracker)
 	if err != nil {
 		log.Error(err, "failed to list all images")
 		os.Exit(1)
 	}
 	log.Info("images collected", "finalImages:", finalImages)
 
+	if err := tracker.Save(); err != nil {
+		log.Error(err, "failed to save image usage", "path", util.ImageUsagePath)
+	}
+
+	if *imageFsHigh > 0 && !underPressure(client) {
+		finalImages = finalImages[:0]
+	}
+
+	// the remover reports the images left out here along with its own results
+	if err := util.WriteExclusionCounts(util.ExclusionCountsPath, exclusionCounts); err != nil {
+		log.Error(err, "failed to write exclusion counts", "path", util.ExclusionCountsPath)
+	}
+
 	data, err := json.Marshal(finalImages)
 	if err != nil {
 		log.Error(err, "failed to encode finalImages")
@@ -2,24 +2,37 @@ package main
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/eraser-dev/eraser/api/unversioned"
 	"github.com/eraser-dev/eraser/pkg/cri"
+	"github.com/eraser-dev/eraser/pkg/imagefs"
+	"github.com/eraser-dev/eraser/pkg/lastused"
+	"github.com/eraser-dev/eraser/pkg/retention"
 	util "github.com/eraser-dev/eraser/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func getImages(c cri.Collector) ([]unversioned.Image, error) {
+// getImages returns the non-running images that are not excluded, and the
+// number of images each exclusion pattern protected. The tracker is updated
+// with the containers on the node, and images used more recently than
+// unusedFor are left out, as are the keepLast most recently created images of
+// each repository. The images are sorted so the ones unused for the longest
+// come first, and among those the largest.
+func getImages(c cri.Collector, tracker *lastused.Tracker) ([]unversioned.Image, map[string]int64, error) {
 	backgroundContext, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 
 	images, err := c.ListImages(backgroundContext)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	allImages := make([]unversioned.Image, 0, len(images))
 	// map with key: imageID, value: repoTag list (contains full name of image)
 	idToImageMap := make(map[string]unversioned.Image)
+	sizes := make(map[string]uint64, len(images))
 
 	for _, img := range images {
 		repoTags := []string{}
@@ -28,6 +41,8 @@ func getImages(c cri.Collector) ([]unversioned.Image, error) {
 		newImg := unversioned.Image{
 			ImageID: img.Id,
 			Names:   repoTags,
+			//nolint:gosec // G115: image sizes fit in an int64
+			Size: int64(img.Size_),
 		}
 
 		digests, errs := util.ProcessRepoDigests(img.RepoDigests)
@@ -39,13 +54,17 @@ func getImages(c cri.Collector) ([]unversioned.Image, error) {
 
 		allImages = append(allImages, newImg)
 		idToImageMap[img.Id] = newImg
+		sizes[img.Id] = img.Size_
 	}
 
 	containers, err := c.ListContainers(backgroundContext)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
+	now := time.Now()
+	tracker.Observe(allImages, containers, now)
+
 	// Images that are running
 	// map of (digest | name) -> imageID
 	runningImages := util.GetRunningImages(containers, idToImageMap)
@@ -54,10 +73,31 @@ func getImages(c cri.Collector) ([]unversioned.Image, error) {
 	// map of (digest | name) -> imageID
 	nonRunningImages := util.GetNonRunningImages(runningImages, allImages, idToImageMap)
 
+	kept := map[string]struct{}{}
+	if *keepLast > 0 {
+		candidates := make([]unversioned.Image, 0, len(allImages))
+		for i := range allImages {
+			if _, isRunning := runningImages[allImages[i].ImageID]; !isRunning {
+				candidates = append(candidates, allImages[i])
+			}
+		}
+
+		kept, err = retention.Retain(backgroundContext, c, candidates, *keepLast)
+		if err != nil {
+			log.Error(err, "failed to get the creation time of images, they are kept")
+		}
+		for i := range candidates {
+			idToImageMap[candidates[i].ImageID] = candidates[i]
+		}
+	}
+
 	finalImages := make([]unversioned.Image, 0, len(images))
+	exclusionCounts := make(map[string]int64)
 
 	// empty map to keep track of repeated digest values due to both name and digest being present as keys in nonRunningImages
 	checked := make(map[string]struct{})
+	recentlyUsed := 0
+	retained := 0
 
 	for _, imageID := range nonRunningImages {
 		if _, alreadyChecked := checked[imageID]; alreadyChecked {
@@ -71,12 +111,76 @@ func getImages(c cri.Collector) ([]unversioned.Image, error) {
 			ImageID: imageID,
 			Names:   img.Names,
 			Digests: img.Digests,
+			Created: img.Created,
+			Size:    img.Size,
+		}
+		if lastUsed := tracker.LastUsed(imageID); lastUsed != nil {
+			currImage.LastUsed = &metav1.Time{Time: *lastUsed}
 		}
 
-		if !util.IsExcluded(excluded, currImage.ImageID, idToImageMap) {
-			finalImages = append(finalImages, currImage)
+		patterns := util.ExcludedBy(excluded, currImage.ImageID, idToImageMap)
+		if len(patterns) != 0 {
+			for _, pattern := range patterns {
+				exclusionCounts[pattern]++
+			}
+			continue
+		}
+
+		if *unusedFor > 0 && now.Sub(tracker.UnusedSince(imageID, now)) < *unusedFor {
+			recentlyUsed++
+			continue
 		}
+
+		if _, ok := kept[imageID]; ok {
+			retained++
+			continue
+		}
+
+		finalImages = append(finalImages, currImage)
+	}
+
+	if *unusedFor > 0 {
+		log.Info("left out recently used images", "count", recentlyUsed, "unusedFor", unusedFor.String())
+	}
+	if *keepLast > 0 {
+		log.Info("left out the newest images of each repository", "count", retained, "keepLast", *keepLast)
+	}
+
+	// the remover stops in this order once enough disk space is freed
+	sort.SliceStable(finalImages, func(i, j int) bool {
+		a, b := finalImages[i].ImageID, finalImages[j].ImageID
+		unusedA, unusedB := tracker.UnusedSince(a, now), tracker.UnusedSince(b, now)
+		if !unusedA.Equal(unusedB) {
+			return unusedA.Before(unusedB)
+		}
+		if sizes[a] != sizes[b] {
+			return sizes[a] > sizes[b]
+		}
+		return a < b
+	})
+
+	return finalImages, exclusionCounts, nil
+}
+
+// underPressure reports whether the image filesystem is used above the high
+// threshold. If the usage cannot be measured, no images are removed.
+func underPressure(c cri.Collector) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	usage, err := imagefs.Measure(ctx, c, util.ImageFsMountPath)
+	if err != nil {
+		log.Error(err, "failed to measure the image filesystem, no images will be removed", "path", util.ImageFsMountPath)
+		return false
+	}
+
+	percent := usage.Percent()
+	log.Info("image filesystem usage", "percent", percent, "threshold", *imageFsHigh,
+		"capacityBytes", usage.CapacityBytes, "usedBytes", usage.UsedBytes, "imageBytes", usage.ImageBytes)
+	if percent <= float64(*imageFsHigh) {
+		log.Info("image filesystem is below the threshold, no images will be removed")
+		return false
 	}
 
-	return finalImages, nil
+	return true
 }
@@ -3,6 +3,7 @@ package cri
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"github.com/eraser-dev/eraser/pkg/utils"
 	"google.golang.org/grpc"
@@ -19,6 +20,8 @@ type (
 	Collector interface {
 		ListImages(context.Context) ([]*v1.Image, error)
 		ListContainers(context.Context) ([]*v1.Container, error)
+		ImageFsInfo(context.Context) ([]*v1.FilesystemUsage, error)
+		ImageStatus(context.Context, string) (*v1.ImageStatusResponse, error)
 	}
 
 	Remover interface {
@@ -33,15 +36,31 @@ func NewCollectorClient(socketPath string) (Collector, error) {
 	return NewRemoverClient(socketPath)
 }
 
+// NewRemoverClient returns a client of the runtime listening on socketPath.
+// Each call has a deadline, and calls failing because the runtime is
+// unavailable are retried with a backoff, reconnecting to the runtime.
 func NewRemoverClient(socketPath string) (Remover, error) {
-	ctx := context.Background()
+	connect := func(ctx context.Context) (Remover, io.Closer, error) {
+		conn, err := utils.GetConn(ctx, socketPath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		client, err := newClientWithFallback(ctx, conn)
+		if err != nil {
+			_ = conn.Close()
+			return nil, nil, err
+		}
+
+		return client, conn, nil
+	}
 
-	conn, err := utils.GetConn(ctx, socketPath)
+	client, err := newResilientClient(context.Background(), connect, defaultRetryPolicy)
 	if err != nil {
 		return nil, err
 	}
 
-	return newClientWithFallback(ctx, conn)
+	return client, nil
 }
 
 func newClientWithFallback(ctx context.Context, conn *grpc.ClientConn) (Remover, error) {
@@ -34,6 +34,21 @@ func (c *v1Client) ListImages(ctx context.Context) (list []*v1.Image, err error)
 	return resp.Images, nil
 }
 
+func (c *v1Client) ImageFsInfo(ctx context.Context) ([]*v1.FilesystemUsage, error) {
+	resp, err := c.images.ImageFsInfo(ctx, new(v1.ImageFsInfoRequest))
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.ImageFilesystems, nil
+}
+
+func (c *v1Client) ImageStatus(ctx context.Context, image string) (*v1.ImageStatusResponse, error) {
+	request := &v1.ImageStatusRequest{Image: &v1.ImageSpec{Image: image}, Verbose: true}
+
+	return c.images.ImageStatus(ctx, request)
+}
+
 func (c *v1Client) DeleteImage(ctx context.Context, image string) (err error) {
 	if image == "" {
 		return err
@@ -35,6 +35,26 @@ func (c *v1alpha2Client) ListImages(ctx context.Context) (list []*v1.Image, err
 	return convertImages(resp.Images), nil
 }
 
+func (c *v1alpha2Client) ImageFsInfo(ctx context.Context) ([]*v1.FilesystemUsage, error) {
+	resp, err := c.images.ImageFsInfo(ctx, new(v1alpha2.ImageFsInfoRequest))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFilesystemUsages(resp.ImageFilesystems), nil
+}
+
+func (c *v1alpha2Client) ImageStatus(ctx context.Context, image string) (*v1.ImageStatusResponse, error) {
+	request := &v1alpha2.ImageStatusRequest{Image: &v1alpha2.ImageSpec{Image: image}, Verbose: true}
+
+	resp, err := c.images.ImageStatus(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ImageStatusResponse{Image: convertImage(resp.Image), Info: resp.Info}, nil
+}
+
 func (c *v1alpha2Client) DeleteImage(ctx context.Context, image string) (err error) {
 	if image == "" {
 		return err
@@ -134,3 +154,28 @@ func convertImage(i *v1alpha2.Image) *v1.Image {
 
 	return img
 }
+
+func convertFilesystemUsages(list []*v1alpha2.FilesystemUsage) []*v1.FilesystemUsage {
+	v1s := []*v1.FilesystemUsage{}
+
+	for _, u := range list {
+		if u == nil {
+			continue
+		}
+
+		usage := &v1.FilesystemUsage{Timestamp: u.Timestamp}
+		if u.FsId != nil {
+			usage.FsId = &v1.FilesystemIdentifier{Mountpoint: u.FsId.Mountpoint}
+		}
+		if u.UsedBytes != nil {
+			usage.UsedBytes = &v1.UInt64Value{Value: u.UsedBytes.Value}
+		}
+		if u.InodesUsed != nil {
+			usage.InodesUsed = &v1.UInt64Value{Value: u.InodesUsed.Value}
+		}
+
+		v1s = append(v1s, usage)
+	}
+
+	return v1s
+}
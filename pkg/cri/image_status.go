@@ -0,0 +1,40 @@
+package cri
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// verboseInfo is the part of the verbose image status that containerd and
+// CRI-O report as JSON under the "info" key.
+type verboseInfo struct {
+	ImageSpec struct {
+		Created *time.Time `json:"created"`
+	} `json:"imageSpec"`
+}
+
+// ImageCreated returns when the image was created, according to the verbose
+// status of the image. It returns nil if the runtime does not report it.
+func ImageCreated(ctx context.Context, c Collector, image string) (*time.Time, error) {
+	resp, err := c.ImageStatus(ctx, image)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCreated(resp.GetInfo())
+}
+
+func parseCreated(info map[string]string) (*time.Time, error) {
+	raw, ok := info["info"]
+	if !ok {
+		return nil, nil
+	}
+
+	var parsed verboseInfo
+	if err := json.Unmarshal([]byte(raw), &parsed); err != nil {
+		return nil, err
+	}
+
+	return parsed.ImageSpec.Created, nil
+}
@@ -0,0 +1,32 @@
+package cri
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCreated(t *testing.T) {
+	created, err := parseCreated(map[string]string{
+		"info": `{"chainID":"sha256:abc","imageSpec":{"created":"2024-03-01T12:00:00Z","architecture":"amd64","os":"linux"}}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC); created == nil || !created.Equal(want) {
+		t.Errorf("expected %v, got %v", want, created)
+	}
+
+	created, err = parseCreated(map[string]string{"info": `{"imageSpec":{}}`})
+	if err != nil || created != nil {
+		t.Errorf("expected no creation time, got %v, %v", created, err)
+	}
+
+	created, err = parseCreated(nil)
+	if err != nil || created != nil {
+		t.Errorf("expected no creation time without info, got %v, %v", created, err)
+	}
+
+	if _, err := parseCreated(map[string]string{"info": "{"}); err == nil {
+		t.Error("expected an error for invalid info")
+	}
+}
@@ -0,0 +1,202 @@
+package cri
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// retryPolicy sets how long a call to the runtime may take and how failed
+// calls are retried.
+type retryPolicy struct {
+	// deadline of each attempt of a call
+	callTimeout time.Duration
+	// number of attempts of a call, including the first one
+	attempts int
+	// wait before the first retry, doubled after each retry up to maxBackoff
+	backoff    time.Duration
+	maxBackoff time.Duration
+}
+
+var defaultRetryPolicy = retryPolicy{
+	callTimeout: time.Minute,
+	attempts:    5,
+	backoff:     500 * time.Millisecond,
+	maxBackoff:  8 * time.Second,
+}
+
+// connectFunc connects to the runtime. The closer releases the connection.
+type connectFunc func(context.Context) (Remover, io.Closer, error)
+
+// resilientClient calls the runtime through another client, retrying the calls
+// that failed because the runtime was unavailable or too slow, such as while
+// it restarts. The connection is dialed again after the runtime was
+// unavailable.
+type resilientClient struct {
+	connect connectFunc
+	policy  retryPolicy
+
+	mu     sync.Mutex
+	client Remover
+	closer io.Closer
+}
+
+var _ Remover = &resilientClient{}
+
+func newResilientClient(ctx context.Context, connect connectFunc, policy retryPolicy) (*resilientClient, error) {
+	c := &resilientClient{connect: connect, policy: policy}
+
+	// connect now, so that a runtime that cannot be reached is reported early
+	if err := c.do(ctx, "connect", func(context.Context, Remover) error { return nil }); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// IsTransient reports whether an error returned by a client is due to the
+// runtime being unavailable or too slow, so that the call may succeed later.
+// Other errors are permanent: the call fails the same way when it is retried.
+func IsTransient(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+func code(err error) codes.Code {
+	if s, ok := status.FromError(err); ok {
+		return s.Code()
+	}
+
+	return status.FromContextError(err).Code()
+}
+
+// get returns the client, connecting to the runtime if needed.
+func (c *resilientClient) get(ctx context.Context) (Remover, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != nil {
+		return c.client, nil
+	}
+
+	client, closer, err := c.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.client, c.closer = client, closer
+	return client, nil
+}
+
+// reset drops the connection of the given client, unless another call
+// already replaced it.
+func (c *resilientClient) reset(client Remover) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client != client {
+		return
+	}
+
+	if c.closer != nil {
+		_ = c.closer.Close()
+	}
+	c.client, c.closer = nil, nil
+}
+
+// do calls f with a client until it succeeds, fails permanently or runs out
+// of attempts. Each attempt has its own deadline, within the one of ctx.
+func (c *resilientClient) do(ctx context.Context, op string, f func(context.Context, Remover) error) error {
+	backoff := c.policy.backoff
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = c.attempt(ctx, f)
+		if err == nil || !IsTransient(err) {
+			return err
+		}
+		if attempt >= c.policy.attempts || ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s: %w", op, err)
+		case <-time.After(backoff):
+		}
+
+		backoff = min(2*backoff, c.policy.maxBackoff)
+	}
+
+	return fmt.Errorf("%s: runtime unavailable after %d attempts: %w", op, c.policy.attempts, err)
+}
+
+func (c *resilientClient) attempt(ctx context.Context, f func(context.Context, Remover) error) error {
+	ctx, cancel := context.WithTimeout(ctx, c.policy.callTimeout)
+	defer cancel()
+
+	client, err := c.get(ctx)
+	if err != nil {
+		// failing to connect is worth retrying until the attempts run out
+		return status.Errorf(codes.Unavailable, "connecting to the runtime: %v", err)
+	}
+
+	err = f(ctx, client)
+	if code(err) == codes.Unavailable {
+		c.reset(client)
+	}
+
+	return err
+}
+
+func (c *resilientClient) ListImages(ctx context.Context) (list []*v1.Image, err error) {
+	err = c.do(ctx, "list images", func(ctx context.Context, client Remover) error {
+		list, err = client.ListImages(ctx)
+		return err
+	})
+	return list, err
+}
+
+func (c *resilientClient) ListContainers(ctx context.Context) (list []*v1.Container, err error) {
+	err = c.do(ctx, "list containers", func(ctx context.Context, client Remover) error {
+		list, err = client.ListContainers(ctx)
+		return err
+	})
+	return list, err
+}
+
+func (c *resilientClient) ImageFsInfo(ctx context.Context) (usage []*v1.FilesystemUsage, err error) {
+	err = c.do(ctx, "image filesystem info", func(ctx context.Context, client Remover) error {
+		usage, err = client.ImageFsInfo(ctx)
+		return err
+	})
+	return usage, err
+}
+
+func (c *resilientClient) ImageStatus(ctx context.Context, image string) (resp *v1.ImageStatusResponse, err error) {
+	err = c.do(ctx, "image status", func(ctx context.Context, client Remover) error {
+		resp, err = client.ImageStatus(ctx, image)
+		return err
+	})
+	return resp, err
+}
+
+func (c *resilientClient) DeleteImage(ctx context.Context, image string) error {
+	return c.do(ctx, "delete image", func(ctx context.Context, client Remover) error {
+		return client.DeleteImage(ctx, image)
+	})
+}
@@ -0,0 +1,162 @@
+package cri
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+var testRetryPolicy = retryPolicy{
+	callTimeout: time.Second,
+	attempts:    3,
+	backoff:     time.Millisecond,
+	maxBackoff:  time.Millisecond,
+}
+
+// flakyClient fails its calls with the given errors, in order, then succeeds.
+type flakyClient struct {
+	Remover
+	errs  []error
+	calls int
+}
+
+func (c *flakyClient) DeleteImage(_ context.Context, _ string) error {
+	c.calls++
+	if len(c.errs) == 0 {
+		return nil
+	}
+
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return err
+}
+
+func (c *flakyClient) ListImages(ctx context.Context) ([]*v1.Image, error) {
+	if err := c.DeleteImage(ctx, ""); err != nil {
+		return nil, err
+	}
+	return []*v1.Image{{Id: "image1"}}, nil
+}
+
+type closeCounter int
+
+func (c *closeCounter) Close() error {
+	*c++
+	return nil
+}
+
+func TestResilientClient(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "connection refused")
+	inUse := status.Error(codes.FailedPrecondition, "image is in use")
+
+	cases := map[string]struct {
+		errs          []error
+		wantErr       bool
+		wantTransient bool
+		wantCalls     int
+		wantConnects  int
+	}{
+		"succeeds": {
+			wantCalls:    1,
+			wantConnects: 1,
+		},
+		"retries and reconnects when unavailable": {
+			errs:         []error{unavailable, unavailable},
+			wantCalls:    3,
+			wantConnects: 3,
+		},
+		"retries on deadlines without reconnecting": {
+			errs:         []error{status.Error(codes.DeadlineExceeded, "slow")},
+			wantCalls:    2,
+			wantConnects: 1,
+		},
+		"gives up after the attempts": {
+			errs:          []error{unavailable, unavailable, unavailable},
+			wantErr:       true,
+			wantTransient: true,
+			wantCalls:     3,
+			wantConnects:  3,
+		},
+		"does not retry permanent errors": {
+			errs:         []error{inUse},
+			wantErr:      true,
+			wantCalls:    1,
+			wantConnects: 1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			flaky := &flakyClient{errs: tc.errs}
+			var connects int
+			var closes closeCounter
+			connect := func(context.Context) (Remover, io.Closer, error) {
+				connects++
+				return flaky, &closes, nil
+			}
+
+			client, err := newResilientClient(context.Background(), connect, testRetryPolicy)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = client.DeleteImage(context.Background(), "image1")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if IsTransient(err) != tc.wantTransient {
+				t.Errorf("expected transient %v, got %v for %v", tc.wantTransient, IsTransient(err), err)
+			}
+			if flaky.calls != tc.wantCalls {
+				t.Errorf("expected %d calls, got %d", tc.wantCalls, flaky.calls)
+			}
+			if connects != tc.wantConnects {
+				t.Errorf("expected %d connections, got %d", tc.wantConnects, connects)
+			}
+			var dropped int
+			for _, err := range tc.errs {
+				if status.Code(err) == codes.Unavailable {
+					dropped++
+				}
+			}
+			if int(closes) != dropped {
+				t.Errorf("expected %d connections to be closed, got %d", dropped, closes)
+			}
+		})
+	}
+}
+
+func TestResilientClientConnect(t *testing.T) {
+	flaky := &flakyClient{}
+	var attempts int
+	connect := func(context.Context) (Remover, io.Closer, error) {
+		attempts++
+		if attempts < 2 {
+			return nil, nil, fmt.Errorf("no such file or directory")
+		}
+		return flaky, nil, nil
+	}
+
+	client, err := newResilientClient(context.Background(), connect, testRetryPolicy)
+	if err != nil {
+		t.Fatalf("expected the connection to be retried, got %v", err)
+	}
+
+	images, err := client.ListImages(context.Background())
+	if err != nil || len(images) != 1 {
+		t.Errorf("expected 1 image, got %v, %v", images, err)
+	}
+
+	_, err = newResilientClient(context.Background(), func(context.Context) (Remover, io.Closer, error) {
+		return nil, nil, fmt.Errorf("no such file or directory")
+	}, testRetryPolicy)
+	if err == nil || !IsTransient(err) {
+		t.Errorf("expected a transient error when the runtime cannot be reached, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+// Package imagefs measures the usage of the image filesystem of a node.
+//
+// The container runtime reports how many bytes the images use through the CRI
+// ImageFsInfo call, but not how large the filesystem is. The size and the
+// space in use come from statfs on a directory of the image filesystem that is
+// mounted into the pod.
+package imagefs
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/eraser-dev/eraser/pkg/cri"
+)
+
+// Usage is the usage of an image filesystem.
+type Usage struct {
+	// Size of the filesystem
+	CapacityBytes int64
+	// Bytes in use on the filesystem, by images or anything else
+	UsedBytes int64
+	// Bytes available to unprivileged users
+	AvailableBytes int64
+	// Bytes used by images, according to the container runtime
+	ImageBytes int64
+}
+
+// Percent returns the percentage of the filesystem in use. Like df, blocks
+// reserved for root do not count as available.
+func (u Usage) Percent() float64 {
+	total := u.UsedBytes + u.AvailableBytes
+	if total <= 0 {
+		return 0
+	}
+
+	return float64(u.UsedBytes) * 100 / float64(total)
+}
+
+// Free returns the usage after freeing the given number of bytes.
+func (u Usage) Free(bytes int64) Usage {
+	u.UsedBytes -= bytes
+	u.AvailableBytes += bytes
+	u.ImageBytes -= bytes
+
+	return u
+}
+
+// Filesystem returns the usage of the filesystem that path is on, without the
+// bytes used by images.
+func Filesystem(path string) (Usage, error) {
+	var st unix.Statfs_t
+	if err := unix.Statfs(path, &st); err != nil {
+		return Usage{}, fmt.Errorf("statfs %s: %w", path, err)
+	}
+
+	bsize := int64(st.Bsize)
+	//nolint:gosec // G115: filesystem sizes fit in an int64
+	return Usage{
+		CapacityBytes:  int64(st.Blocks) * bsize,
+		UsedBytes:      int64(st.Blocks-st.Bfree) * bsize,
+		AvailableBytes: int64(st.Bavail) * bsize,
+	}, nil
+}
+
+// Measure returns the usage of the filesystem that path is on, along with the
+// bytes the images use according to the runtime.
+func Measure(ctx context.Context, c cri.Collector, path string) (Usage, error) {
+	usage, err := Filesystem(path)
+	if err != nil {
+		return Usage{}, err
+	}
+
+	filesystems, err := c.ImageFsInfo(ctx)
+	if err != nil {
+		return Usage{}, fmt.Errorf("image filesystem info: %w", err)
+	}
+
+	for _, fs := range filesystems {
+		//nolint:gosec // G115: filesystem sizes fit in an int64
+		usage.ImageBytes += int64(fs.GetUsedBytes().GetValue())
+	}
+
+	return usage, nil
+}
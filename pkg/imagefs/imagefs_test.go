@@ -0,0 +1,71 @@
+package imagefs
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+type fakeCollector struct {
+	used []uint64
+}
+
+func (f *fakeCollector) ListImages(context.Context) ([]*v1.Image, error) {
+	return nil, nil
+}
+
+func (f *fakeCollector) ListContainers(context.Context) ([]*v1.Container, error) {
+	return nil, nil
+}
+
+func (f *fakeCollector) ImageFsInfo(context.Context) ([]*v1.FilesystemUsage, error) {
+	filesystems := make([]*v1.FilesystemUsage, 0, len(f.used))
+	for _, used := range f.used {
+		filesystems = append(filesystems, &v1.FilesystemUsage{UsedBytes: &v1.UInt64Value{Value: used}})
+	}
+	return filesystems, nil
+}
+
+func (f *fakeCollector) ImageStatus(context.Context, string) (*v1.ImageStatusResponse, error) {
+	return &v1.ImageStatusResponse{}, nil
+}
+
+func TestPercent(t *testing.T) {
+	usage := Usage{CapacityBytes: 1000, UsedBytes: 800, AvailableBytes: 150, ImageBytes: 500}
+
+	// blocks reserved for root count as neither used nor available
+	if got := usage.Percent(); got < 84.2 || got > 84.3 {
+		t.Errorf("expected 84.2%%, got %v", got)
+	}
+
+	freed := usage.Free(420)
+	if got := freed.Percent(); got != 40 {
+		t.Errorf("expected 40%% after freeing, got %v", got)
+	}
+	if freed.ImageBytes != 80 || freed.CapacityBytes != 1000 {
+		t.Errorf("unexpected usage after freeing: %+v", freed)
+	}
+
+	if got := (Usage{}).Percent(); got != 0 {
+		t.Errorf("expected an empty filesystem to be unused, got %v", got)
+	}
+}
+
+func TestMeasure(t *testing.T) {
+	usage, err := Measure(context.Background(), &fakeCollector{used: []uint64{100, 50}}, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if usage.ImageBytes != 150 {
+		t.Errorf("expected 150 image bytes, got %d", usage.ImageBytes)
+	}
+	if usage.CapacityBytes <= 0 || usage.UsedBytes > usage.CapacityBytes {
+		t.Errorf("unexpected filesystem usage: %+v", usage)
+	}
+
+	if _, err := Measure(context.Background(), &fakeCollector{}, "/does/not/exist"); err == nil {
+		t.Error("expected an error for a missing path")
+	}
+}
@@ -0,0 +1,273 @@
+// Package imagepattern matches images against the patterns of ImageLists and
+// exclusion lists.
+//
+// A pattern is one of:
+//   - A literal image reference, digest or image ID. References are also
+//     matched in their normalized form, so "alpine" matches
+//     "docker.io/library/alpine:latest".
+//   - A glob, which contains "*" or "?". "*" matches any characters except
+//     "/", "**" matches any characters and "?" matches one character other
+//     than "/". A trailing "/*" matches everything below the path, so
+//     "docker.io/library/*" matches "docker.io/library/alpine:3" but not
+//     "docker.io/library-extra/alpine:3". Globs are normalized like
+//     references unless they start with a wildcard: "app:v1.*" is
+//     "docker.io/library/app:v1.*".
+//   - A regular expression between slashes, such as "/.*:v1\.[0-9]+/". It has
+//     to match the whole name.
+//
+// Patterns are matched against the image ID, names and digests of an image.
+package imagepattern
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/distribution/reference"
+)
+
+// Kind is the kind of a pattern. When several patterns of an ImageList match
+// an image, the pattern of the lowest kind wins, and within a kind the first
+// one in the list.
+type Kind int
+
+const (
+	Literal Kind = iota
+	Glob
+	Regexp
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Literal:
+		return "literal"
+	case Glob:
+		return "glob"
+	case Regexp:
+		return "regexp"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Pattern is a parsed image pattern.
+type Pattern struct {
+	raw     string
+	kind    Kind
+	literal string
+	re      *regexp.Regexp
+}
+
+// Parse parses a pattern.
+func Parse(s string) (*Pattern, error) {
+	switch {
+	case s == "":
+		return nil, fmt.Errorf("empty pattern")
+
+	case len(s) > 2 && strings.HasPrefix(s, "/") && strings.HasSuffix(s, "/"):
+		re, err := regexp.Compile("^(?:" + s[1:len(s)-1] + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", s, err)
+		}
+		return &Pattern{raw: s, kind: Regexp, re: re}, nil
+
+	case strings.ContainsAny(s, "*?"):
+		re, err := regexp.Compile(globToRegexp(normalizeGlob(s)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid glob %q: %w", s, err)
+		}
+		return &Pattern{raw: s, kind: Glob, re: re}, nil
+
+	default:
+		return &Pattern{raw: s, kind: Literal, literal: Normalize(s)}, nil
+	}
+}
+
+// String returns the pattern as it was given.
+func (p *Pattern) String() string {
+	return p.raw
+}
+
+// Kind returns the kind of the pattern.
+func (p *Pattern) Kind() Kind {
+	return p.kind
+}
+
+// Match reports whether the pattern matches any of the names.
+func (p *Pattern) Match(names ...string) bool {
+	for _, name := range candidates(names) {
+		if p.match(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *Pattern) match(name string) bool {
+	if p.kind == Literal {
+		return name == p.raw || name == p.literal
+	}
+
+	return p.re.MatchString(name)
+}
+
+// Normalize returns the fully qualified form of an image reference, such as
+// "docker.io/library/alpine:latest" for "alpine". Anything that is not a
+// reference, such as an image ID, is returned as is.
+func Normalize(s string) string {
+	// digests and image IDs also parse as references
+	if strings.HasPrefix(s, "sha256:") {
+		return s
+	}
+
+	named, err := reference.ParseNormalizedNamed(s)
+	if err != nil {
+		return s
+	}
+
+	return reference.TagNameOnly(named).String()
+}
+
+// normalizeGlob adds the default registry, and the library namespace for
+// single component names, to a glob that does not start with a registry.
+func normalizeGlob(glob string) string {
+	first, _, found := strings.Cut(glob, "/")
+	switch {
+	case first == "" || strings.ContainsAny(first[:1], "*?"), strings.HasPrefix(glob, "sha256:"):
+		return glob
+	case !found:
+		return "docker.io/library/" + glob
+	case strings.ContainsAny(first, ".:") || first == "localhost":
+		return glob
+	default:
+		return "docker.io/" + glob
+	}
+}
+
+func globToRegexp(glob string) string {
+	// a trailing "/*" has always matched everything below the path
+	if strings.HasSuffix(glob, "/*") {
+		glob += "*"
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				b.WriteString(".*")
+				i++
+				continue
+			}
+			b.WriteString("[^/]*")
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+
+	return b.String()
+}
+
+// candidates returns the names along with their normalized forms.
+func candidates(names []string) []string {
+	result := make([]string, 0, 2*len(names))
+	for _, name := range names {
+		result = append(result, name)
+		if normalized := Normalize(name); normalized != name {
+			result = append(result, normalized)
+		}
+	}
+
+	return result
+}
+
+// Set is a set of patterns, such as an exclusion list. A nil Set is empty.
+type Set struct {
+	// literal patterns by their raw and normalized form
+	literals map[string][]string
+	patterns []*Pattern
+	size     int
+}
+
+// NewSet parses the patterns into a set.
+func NewSet(patterns []string) (*Set, error) {
+	s := &Set{literals: map[string][]string{}}
+	seen := map[string]struct{}{}
+	for _, raw := range patterns {
+		if _, ok := seen[raw]; ok {
+			continue
+		}
+		seen[raw] = struct{}{}
+		s.size++
+
+		p, err := Parse(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.kind != Literal {
+			s.patterns = append(s.patterns, p)
+			continue
+		}
+
+		s.literals[p.raw] = append(s.literals[p.raw], p.raw)
+		if p.literal != p.raw {
+			s.literals[p.literal] = append(s.literals[p.literal], p.raw)
+		}
+	}
+
+	return s, nil
+}
+
+// Len returns the number of patterns in the set.
+func (s *Set) Len() int {
+	if s == nil {
+		return 0
+	}
+
+	return s.size
+}
+
+// Matching returns the sorted patterns of the set that match any of the
+// names.
+func (s *Set) Matching(names ...string) []string {
+	if s == nil {
+		return nil
+	}
+
+	names = candidates(names)
+
+	matched := map[string]struct{}{}
+	for _, name := range names {
+		for _, raw := range s.literals[name] {
+			matched[raw] = struct{}{}
+		}
+	}
+
+	for _, p := range s.patterns {
+		for _, name := range names {
+			if p.match(name) {
+				matched[p.raw] = struct{}{}
+				break
+			}
+		}
+	}
+
+	if len(matched) == 0 {
+		return nil
+	}
+
+	result := make([]string, 0, len(matched))
+	for raw := range matched {
+		result = append(result, raw)
+	}
+	sort.Strings(result)
+
+	return result
+}
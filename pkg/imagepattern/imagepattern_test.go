@@ -0,0 +1,133 @@
+package imagepattern
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		kind    Kind
+		matches []string
+		misses  []string
+	}{
+		{
+			pattern: "docker.io/library/alpine:3",
+			kind:    Literal,
+			matches: []string{"docker.io/library/alpine:3", "alpine:3"},
+			misses:  []string{"docker.io/library/alpine:3.18", "docker.io/library/alpine"},
+		},
+		{
+			pattern: "alpine",
+			kind:    Literal,
+			matches: []string{"docker.io/library/alpine:latest", "alpine"},
+			misses:  []string{"docker.io/library/alpine:3"},
+		},
+		{
+			pattern: "sha256:1234",
+			kind:    Literal,
+			matches: []string{"sha256:1234"},
+			misses:  []string{"docker.io/library/sha256:1234"},
+		},
+		{
+			pattern: "docker.io/library/*",
+			kind:    Glob,
+			matches: []string{"docker.io/library/alpine:3", "docker.io/library/team/app:1", "alpine:3"},
+			misses:  []string{"docker.io/library-extra/alpine:3", "docker.io/other/alpine:3"},
+		},
+		{
+			pattern: "docker.io/library/alpine:*",
+			kind:    Glob,
+			matches: []string{"docker.io/library/alpine:3", "docker.io/library/alpine:edge"},
+			misses:  []string{"docker.io/library/alpine-extra:3", "docker.io/library/alpine/sub:3"},
+		},
+		{
+			pattern: "*/internal/**",
+			kind:    Glob,
+			matches: []string{"registry.example.com/internal/app:1", "registry.example.com/internal/team/app:1"},
+			misses:  []string{"registry.example.com/public/app:1", "registry.example.com/team/internal/app:1"},
+		},
+		{
+			pattern: "app:v1.*",
+			kind:    Glob,
+			matches: []string{"docker.io/library/app:v1.2", "app:v1.0"},
+			misses:  []string{"docker.io/library/app:v2.0", "quay.io/app:v1.2"},
+		},
+		{
+			pattern: "team/app-?:*",
+			kind:    Glob,
+			matches: []string{"docker.io/team/app-a:1"},
+			misses:  []string{"docker.io/team/app-ab:1", "quay.io/team/app-a:1"},
+		},
+		{
+			pattern: `/.*:v1\.[0-9]+/`,
+			kind:    Regexp,
+			matches: []string{"quay.io/app:v1.2", "docker.io/library/app:v1.10"},
+			misses:  []string{"quay.io/app:v1.2-rc", "quay.io/app:v2.0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			p, err := Parse(tc.pattern)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p.Kind() != tc.kind {
+				t.Errorf("expected kind %s, got %s", tc.kind, p.Kind())
+			}
+
+			for _, name := range tc.matches {
+				if !p.Match(name) {
+					t.Errorf("expected %s to match", name)
+				}
+			}
+			for _, name := range tc.misses {
+				if p.Match(name) {
+					t.Errorf("expected %s not to match", name)
+				}
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, pattern := range []string{"", "/a(b/"} {
+		if _, err := Parse(pattern); err == nil {
+			t.Errorf("expected %q to be invalid", pattern)
+		}
+	}
+}
+
+func TestSetMatching(t *testing.T) {
+	set, err := NewSet([]string{
+		"docker.io/library/*",
+		"alpine:3",
+		"docker.io/library/alpine:3",
+		"/.*alpine.*/",
+		"quay.io/**",
+		"alpine:3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if set.Len() != 5 {
+		t.Errorf("expected 5 patterns, got %d", set.Len())
+	}
+
+	expected := []string{"/.*alpine.*/", "alpine:3", "docker.io/library/*", "docker.io/library/alpine:3"}
+	if got := set.Matching("sha256:1234", "docker.io/library/alpine:3"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	if got := set.Matching("ghcr.io/eraser-dev/remover:v1"); got != nil {
+		t.Errorf("expected no patterns to match, got %v", got)
+	}
+
+	var empty *Set
+	if empty.Len() != 0 || empty.Matching("alpine") != nil {
+		t.Error("expected a nil set to be empty")
+	}
+}
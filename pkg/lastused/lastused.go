@@ -0,0 +1,157 @@
+// Package lastused keeps track of when the images on a node were last used by
+// a container. CRI does not record this, so the collector observes the
+// containers on every run and persists what it saw on the host.
+package lastused
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	v1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// Record is what the tracker knows about one image.
+type Record struct {
+	// When the image was first seen on the node.
+	FirstSeen time.Time `json:"firstSeen"`
+	// When a container last used the image, if one has since it was first
+	// seen.
+	LastUsed *time.Time `json:"lastUsed,omitempty"`
+}
+
+// Tracker records when images were last used, by image ID.
+type Tracker struct {
+	path    string
+	records map[string]*Record
+}
+
+// New returns an empty tracker that is saved to path.
+func New(path string) *Tracker {
+	return &Tracker{path: path, records: map[string]*Record{}}
+}
+
+// Load reads the tracker saved to path. A missing file results in an empty
+// tracker.
+func Load(path string) (*Tracker, error) {
+	t := New(path)
+
+	//nolint:gosec // G304: Reading the state file is intended functionality
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return t, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &t.records); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if t.records == nil {
+		t.records = map[string]*Record{}
+	}
+
+	return t, nil
+}
+
+// Observe updates the tracker with the images and containers currently on
+// the node. Running containers use their image now. For other containers the
+// creation time is the latest use that is known. Images that are no longer
+// on the node are forgotten.
+func (t *Tracker) Observe(images []unversioned.Image, containers []*v1.Container, now time.Time) {
+	// map of (ID | name | digest) -> ID
+	ids := make(map[string]string, len(images))
+	present := make(map[string]struct{}, len(images))
+	for _, img := range images {
+		present[img.ImageID] = struct{}{}
+		ids[img.ImageID] = img.ImageID
+		for _, name := range img.Names {
+			ids[name] = img.ImageID
+		}
+		for _, digest := range img.Digests {
+			ids[digest] = img.ImageID
+		}
+
+		if _, ok := t.records[img.ImageID]; !ok {
+			t.records[img.ImageID] = &Record{FirstSeen: now}
+		}
+	}
+
+	for id := range t.records {
+		if _, ok := present[id]; !ok {
+			delete(t.records, id)
+		}
+	}
+
+	for _, container := range containers {
+		id, ok := ids[container.GetImageRef()]
+		if !ok {
+			id, ok = ids[container.GetImage().GetImage()]
+		}
+		if !ok {
+			continue
+		}
+
+		used := now
+		if container.GetState() != v1.ContainerState_CONTAINER_RUNNING {
+			used = time.Unix(0, container.GetCreatedAt())
+		}
+
+		record := t.records[id]
+		if record.LastUsed == nil || used.After(*record.LastUsed) {
+			record.LastUsed = &used
+		}
+	}
+}
+
+// LastUsed returns when a container last used the image, or nil if none has
+// since the image was first seen.
+func (t *Tracker) LastUsed(imageID string) *time.Time {
+	record, ok := t.records[imageID]
+	if !ok || record.LastUsed == nil {
+		return nil
+	}
+
+	lastUsed := *record.LastUsed
+	return &lastUsed
+}
+
+// UnusedSince returns the time since which the image is known to be unused:
+// when it was last used, or when it was first seen if it has not been used
+// since. Images that have not been observed are unused since now.
+func (t *Tracker) UnusedSince(imageID string, now time.Time) time.Time {
+	record, ok := t.records[imageID]
+	switch {
+	case !ok:
+		return now
+	case record.LastUsed != nil:
+		return *record.LastUsed
+	default:
+		return record.FirstSeen
+	}
+}
+
+// Save writes the tracker to its path. The file is replaced atomically, so an
+// interrupted save leaves the previous state in place.
+func (t *Tracker) Save() error {
+	data, err := json.Marshal(t.records)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o750); err != nil {
+		return err
+	}
+
+	tmp := t.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, t.path)
+}
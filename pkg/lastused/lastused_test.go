@@ -0,0 +1,98 @@
+package lastused
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	v1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+func TestObserve(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	created := start.Add(-time.Hour)
+
+	images := []unversioned.Image{
+		{ImageID: "sha256:running", Names: []string{"docker.io/library/nginx:latest"}},
+		{ImageID: "sha256:exited", Names: []string{"docker.io/library/busybox:latest"}},
+		{ImageID: "sha256:unused"},
+	}
+	containers := []*v1.Container{
+		{
+			Image:    &v1.ImageSpec{Image: "docker.io/library/nginx:latest"},
+			State:    v1.ContainerState_CONTAINER_RUNNING,
+			ImageRef: "sha256:running",
+		},
+		{
+			Image:     &v1.ImageSpec{Image: "docker.io/library/busybox:latest"},
+			State:     v1.ContainerState_CONTAINER_EXITED,
+			CreatedAt: created.UnixNano(),
+		},
+	}
+
+	tracker := New(filepath.Join(t.TempDir(), "usage.json"))
+	tracker.Observe(images, containers, start)
+
+	if got := tracker.LastUsed("sha256:running"); got == nil || !got.Equal(start) {
+		t.Errorf("expected running image to be used at %v, got %v", start, got)
+	}
+	if got := tracker.LastUsed("sha256:exited"); got == nil || !got.Equal(created) {
+		t.Errorf("expected exited image to be used at %v, got %v", created, got)
+	}
+	if got := tracker.LastUsed("sha256:unused"); got != nil {
+		t.Errorf("expected unused image to have no last use, got %v", got)
+	}
+
+	// a day later, nothing runs and the exited image is gone
+	later := start.Add(24 * time.Hour)
+	tracker.Observe([]unversioned.Image{images[0], images[2]}, nil, later)
+
+	if got := tracker.UnusedSince("sha256:running", later); !got.Equal(start) {
+		t.Errorf("expected running image to be unused since %v, got %v", start, got)
+	}
+	if got := tracker.UnusedSince("sha256:unused", later); !got.Equal(start) {
+		t.Errorf("expected unused image to be unused since it was first seen at %v, got %v", start, got)
+	}
+	if got := tracker.UnusedSince("sha256:exited", later); !got.Equal(later) {
+		t.Errorf("expected removed image to be forgotten, got %v", got)
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "eraser", "usage.json")
+
+	tracker, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tracker.Observe(
+		[]unversioned.Image{{ImageID: "sha256:a"}, {ImageID: "sha256:b"}},
+		[]*v1.Container{{ImageRef: "sha256:a", State: v1.ContainerState_CONTAINER_RUNNING}},
+		now,
+	)
+	if err := tracker.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := loaded.LastUsed("sha256:a"); got == nil || !got.Equal(now) {
+		t.Errorf("expected last use at %v, got %v", now, got)
+	}
+	if got := loaded.UnusedSince("sha256:b", now.Add(time.Hour)); !got.Equal(now) {
+		t.Errorf("expected unused since %v, got %v", now, got)
+	}
+
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("expected an error for a corrupt file")
+	}
+}
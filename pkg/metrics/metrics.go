@@ -16,6 +16,9 @@ import (
 const (
 	ImagesRemovedCounter     = "images_removed_run_total"
 	ImagesRemovedDescription = "total images removed"
+
+	ImagesReclaimedCounter     = "images_reclaimed_run_bytes"
+	ImagesReclaimedDescription = "total bytes reclaimed by removing images"
 )
 
 func ConfigureMetrics(ctx context.Context, log logr.Logger, endpoint string) (sdkmetric.Exporter, sdkmetric.Reader, *sdkmetric.MeterProvider) {
@@ -61,13 +64,20 @@ func ExportMetrics(log logr.Logger, exporter sdkmetric.Exporter, reader sdkmetri
 	}
 }
 
-func RecordMetricsRemover(ctx context.Context, p metric.MeterProvider, totalRemoved int64) error {
+func RecordMetricsRemover(ctx context.Context, p metric.MeterProvider, totalRemoved, reclaimedBytes int64) error {
 	counter, err := p.Meter("eraser").Int64Counter(ImagesRemovedCounter, metric.WithDescription(ImagesRemovedDescription), metric.WithUnit("1"))
 	if err != nil {
 		return err
 	}
 
 	counter.Add(ctx, totalRemoved, metric.WithAttributes(attribute.String("node name", os.Getenv("NODE_NAME"))))
+
+	reclaimed, err := p.Meter("eraser").Int64Counter(ImagesReclaimedCounter, metric.WithDescription(ImagesReclaimedDescription), metric.WithUnit("By"))
+	if err != nil {
+		return err
+	}
+
+	reclaimed.Add(ctx, reclaimedBytes, metric.WithAttributes(attribute.String("node name", os.Getenv("NODE_NAME"))))
 	return nil
 }
 
@@ -29,7 +29,7 @@ func TestConfigureMetrics(t *testing.T) {
 }
 
 func TestRecordMetrics(t *testing.T) {
-	if err := RecordMetricsRemover(context.Background(), otel.GetMeterProvider(), 1); err != nil {
+	if err := RecordMetricsRemover(context.Background(), otel.GetMeterProvider(), 1, 1024); err != nil {
 		t.Fatal("could not record eraser metrics")
 	}
 
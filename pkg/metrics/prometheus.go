@@ -0,0 +1,101 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The metrics below are kept by the manager and served in the Prometheus
+// format by its metrics server, so they can be scraped without an
+// OpenTelemetry collector. The remover and scanner pods report their results
+// to the manager through their termination messages. The image counters are
+// totals for the cluster: a label per node would add a series for every node
+// that ever ran a job, which grows without bound in autoscaled clusters.
+var (
+	imageJobsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "eraser",
+		Name:      "imagejobs_total",
+		Help:      "Total ImageJobs run, by type and phase",
+	}, []string{"type", "phase"})
+
+	imageJobDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "eraser",
+		Name:      "imagejob_duration_seconds",
+		Help:      "Time for ImageJobs to complete",
+		Buckets:   []float64{10, 30, 60, 120, 300, 600, 1800, 3600},
+	}, []string{"type"})
+
+	podsCompletedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "eraser",
+		Name:      "pods_completed_total",
+		Help:      "Total pods of ImageJobs completed",
+	}, []string{"type"})
+
+	podsFailedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "eraser",
+		Name:      "pods_failed_total",
+		Help:      "Total pods of ImageJobs failed",
+	}, []string{"type"})
+
+	imagesRemovedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "eraser",
+		Name:      "images_removed_total",
+		Help:      "Total images removed",
+	})
+
+	imagesReclaimedBytesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "eraser",
+		Name:      "images_reclaimed_bytes_total",
+		Help:      "Total bytes reclaimed by removing images",
+	})
+
+	vulnerableImagesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "eraser",
+		Name:      "vulnerable_images_total",
+		Help:      "Total vulnerable images detected by the scanner",
+	})
+
+	scanFailedImagesTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "eraser",
+		Name:      "scan_failed_images_total",
+		Help:      "Total images the scanner failed to scan",
+	})
+)
+
+// Collectors returns the collectors of the manager metrics, to register with
+// the registry of the metrics server.
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		imageJobsTotal,
+		imageJobDuration,
+		podsCompletedTotal,
+		podsFailedTotal,
+		imagesRemovedTotal,
+		imagesReclaimedBytesTotal,
+		vulnerableImagesTotal,
+		scanFailedImagesTotal,
+	}
+}
+
+// ObserveImageJob records a completed or failed ImageJob of the given type,
+// collector or manual.
+func ObserveImageJob(jobType, phase string, duration time.Duration, podsCompleted, podsFailed int) {
+	imageJobsTotal.WithLabelValues(jobType, phase).Inc()
+	imageJobDuration.WithLabelValues(jobType).Observe(duration.Seconds())
+	podsCompletedTotal.WithLabelValues(jobType).Add(float64(podsCompleted))
+	podsFailedTotal.WithLabelValues(jobType).Add(float64(podsFailed))
+}
+
+// ObserveRemoval records the images a remover removed from a node.
+func ObserveRemoval(removed, reclaimedBytes int64) {
+	imagesRemovedTotal.Add(float64(removed))
+	imagesReclaimedBytesTotal.Add(float64(reclaimedBytes))
+}
+
+// ObserveScan records the vulnerable images a scanner found on a node, and
+// the ones it failed to scan.
+func ObserveScan(vulnerable, failed int64) {
+	vulnerableImagesTotal.Add(float64(vulnerable))
+	scanFailedImagesTotal.Add(float64(failed))
+}
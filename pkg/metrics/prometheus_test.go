@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollectors(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	for _, c := range Collectors() {
+		if err := registry.Register(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	ObserveImageJob("manual", "Completed", time.Minute, 2, 1)
+	ObserveRemoval(3, 1024)
+	ObserveRemoval(1, 512)
+	ObserveScan(4, 1)
+
+	if got := testutil.ToFloat64(imageJobsTotal.WithLabelValues("manual", "Completed")); got != 1 {
+		t.Errorf("expected 1 job, got %v", got)
+	}
+	if got := testutil.ToFloat64(podsFailedTotal.WithLabelValues("manual")); got != 1 {
+		t.Errorf("expected 1 failed pod, got %v", got)
+	}
+	if got := testutil.ToFloat64(imagesRemovedTotal); got != 4 {
+		t.Errorf("expected 4 images removed, got %v", got)
+	}
+	if got := testutil.ToFloat64(imagesReclaimedBytesTotal); got != 1536 {
+		t.Errorf("expected 1536 bytes reclaimed, got %v", got)
+	}
+	if got := testutil.ToFloat64(vulnerableImagesTotal); got != 4 {
+		t.Errorf("expected 4 vulnerable images, got %v", got)
+	}
+	if got := testutil.ToFloat64(scanFailedImagesTotal); got != 1 {
+		t.Errorf("expected 1 image failed to scan, got %v", got)
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"github.com/go-logr/logr"
+	"golang.org/x/time/rate"
+
+	"github.com/eraser-dev/eraser/pkg/cri"
+)
+
+// deleter deletes images in the background with a bounded number of workers,
+// at most at the given rate, so that removing many images does not overload
+// the container runtime.
+type deleter struct {
+	ctx     context.Context
+	c       cri.Remover
+	limiter *rate.Limiter
+	slots   chan struct{}
+	wg      sync.WaitGroup
+
+	mu sync.Mutex
+	// bytes freed by the deletions that completed
+	freed int64
+	// deletions dispatched, by index
+	deletions map[int]deletion
+	// errors of the deletions that failed, by index
+	errs map[int]error
+}
+
+type deletion struct {
+	imageID string
+	size    int64
+	log     logr.Logger
+}
+
+// newDeleter returns a deleter running up to workers deletions at once, and
+// starting at most perSecond of them every second. Zero means no rate limit.
+func newDeleter(ctx context.Context, c cri.Remover, workers, perSecond int) *deleter {
+	if workers < 1 {
+		workers = 1
+	}
+
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	if perSecond > 0 {
+		limiter = rate.NewLimiter(rate.Limit(perSecond), 1)
+	}
+
+	return &deleter{
+		ctx:       ctx,
+		c:         c,
+		limiter:   limiter,
+		slots:     make(chan struct{}, workers),
+		deletions: make(map[int]deletion),
+		errs:      make(map[int]error),
+	}
+}
+
+// delete deletes an image of the given size once a worker is free. The index
+// identifies the deletion in the errors returned by wait.
+func (d *deleter) delete(index int, imageID string, size int64, log logr.Logger) {
+	d.mu.Lock()
+	d.deletions[index] = deletion{imageID: imageID, size: size, log: log}
+	d.mu.Unlock()
+
+	d.slots <- struct{}{}
+	d.wg.Add(1)
+
+	go func() {
+		defer func() {
+			<-d.slots
+			d.wg.Done()
+		}()
+
+		err := d.limiter.Wait(d.ctx)
+		if err == nil {
+			err = d.c.DeleteImage(d.ctx, imageID)
+		}
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		if err != nil {
+			log.Error(err, "error removing image")
+			d.errs[index] = err
+			return
+		}
+
+		log.Info("removed image")
+		delete(d.errs, index)
+		d.freed += size
+	}()
+}
+
+// wait waits for the deletions in flight and returns the errors of all the
+// failed deletions so far, by index.
+func (d *deleter) wait() map[int]error {
+	d.wg.Wait()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.errs
+}
+
+// retryTransient deletes again the images whose deletion failed because the
+// runtime was unavailable or too slow, and returns how many there are. The
+// images whose deletion failed permanently are left.
+func (d *deleter) retryTransient() int {
+	if d.ctx.Err() != nil {
+		return 0
+	}
+
+	d.mu.Lock()
+	var retries []int
+	for index, err := range d.errs {
+		if cri.IsTransient(err) {
+			retries = append(retries, index)
+		}
+	}
+	d.mu.Unlock()
+
+	for _, index := range retries {
+		d.mu.Lock()
+		del := d.deletions[index]
+		d.mu.Unlock()
+
+		d.delete(index, del.imageID, del.size, del.log)
+	}
+
+	return len(retries)
+}
+
+// freedBytes returns the bytes freed by the deletions that completed.
+func (d *deleter) freedBytes() int64 {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.freed
+}
@@ -2,26 +2,32 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"github.com/eraser-dev/eraser/api/unversioned"
 	"github.com/eraser-dev/eraser/pkg/cri"
+	"github.com/eraser-dev/eraser/pkg/imagefs"
+	"github.com/eraser-dev/eraser/pkg/imagepattern"
+	"github.com/eraser-dev/eraser/pkg/retention"
 	util "github.com/eraser-dev/eraser/pkg/utils"
 )
 
-func removeImages(c cri.Remover, targetImages []string) (int, error) {
-	removed := 0
+func removeImages(c cri.Remover, targetImages []string) ([]unversioned.ImageResult, error) {
+	var results []unversioned.ImageResult
 
-	backgroundContext, cancel := context.WithTimeout(context.Background(), timeout)
+	backgroundContext, cancel := context.WithTimeout(context.Background(), *timeout)
 	defer cancel()
 
 	images, err := c.ListImages(backgroundContext)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	allImages := make([]unversioned.Image, 0, len(images))
 	// map with key: imageID, value: repoTag list (contains full name of image)
 	idToImageMap := make(map[string]unversioned.Image)
+	sizes := make(map[string]int64, len(images))
 
 	for _, img := range images {
 		repoTags := []string{}
@@ -40,11 +46,13 @@ func removeImages(c cri.Remover, targetImages []string) (int, error) {
 		newImg.Digests = append(newImg.Digests, digests...)
 		allImages = append(allImages, newImg)
 		idToImageMap[img.Id] = newImg
+		//nolint:gosec // G115: image sizes fit in an int64
+		sizes[img.Id] = int64(img.Size_)
 	}
 
 	containers, err := c.ListContainers(backgroundContext)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	// Images that are running
@@ -60,70 +68,282 @@ func removeImages(c cri.Remover, targetImages []string) (int, error) {
 	log.V(1).Info("Map of running images", "runningImages", runningImages)
 	log.V(1).Info("Map of digest to image name(s)", "idToImageMap", idToImageMap)
 
-	// remove target images
+	// images are deleted in the background, their errors are applied to the
+	// results once all the deletions completed
+	d := newDeleter(backgroundContext, c, *concurrency, *deletesPerSec)
+	// bytes that would be freed in a dry run
+	var wouldFree int64
+	// deletions dispatched since the image filesystem was last checked
+	var pending int
+
+	// remove removes a non-running image, unless it is excluded or enough
+	// disk space has been freed, and adds the result under the given name
+	remove := func(given, imageID, matchedBy string) {
+		result := unversioned.ImageResult{Image: given, MatchedBy: matchedBy}
+
+		if patterns := util.ExcludedBy(excluded, imageID, idToImageMap); len(patterns) > 0 {
+			log.Info("image is excluded", "given", given, "imageID", imageID, "name", idToImageMap[imageID], "patterns", patterns)
+			result.Result = unversioned.ImageExcluded
+			result.ExcludedBy = patterns
+			results = append(results, result)
+			return
+		}
+
+		if enoughFreed != nil {
+			// the bytes freed are only known once the deletions in flight
+			// completed, so at most one deletion per worker goes past the low
+			// threshold
+			if pending >= *concurrency {
+				d.wait()
+				pending = 0
+			}
+			if enoughFreed(wouldFree + d.freedBytes()) {
+				log.Info("image not removed, the image filesystem is below the low threshold", "given", given, "imageID", imageID, "name", idToImageMap[imageID])
+				result.Result = unversioned.ImageSkipped
+				results = append(results, result)
+				return
+			}
+		}
+
+		if *dryRun {
+			log.Info("would remove image", "given", given, "imageID", imageID, "name", idToImageMap[imageID])
+			wouldFree += sizes[imageID]
+			result.Result = unversioned.ImageWouldRemove
+			result.Size = sizes[imageID]
+			results = append(results, result)
+			return
+		}
+
+		// the result is replaced if the deletion fails
+		result.Result = unversioned.ImageRemoved
+		result.Size = sizes[imageID]
+		d.delete(len(results), imageID, sizes[imageID], log.WithValues("given", given, "imageID", imageID, "name", idToImageMap[imageID]))
+		results = append(results, result)
+		pending++
+	}
+
+	// remove target images. The images given by name or ID come first, then
+	// the ones matched by globs and then by regular expressions, each in the
+	// order of the list. "*" removes the remaining images.
 	var prune bool
-	deletedImages := make(map[string]struct{}, len(targetImages))
+	var matchers []*imagepattern.Pattern
+	handled := make(map[string]struct{}, len(targetImages))
 	for _, imgDigestOrTag := range targetImages {
 		if imgDigestOrTag == "*" {
 			prune = true
 			continue
 		}
 
-		if imageID, isNonRunning := nonRunningImages[imgDigestOrTag]; isNonRunning {
-			if ex := util.IsExcluded(excluded, imgDigestOrTag, idToImageMap); ex {
-				log.Info("image is excluded", "given", imgDigestOrTag, "imageID", imageID, "name", idToImageMap[imageID])
-				continue
-			}
-
-			err = c.DeleteImage(backgroundContext, imageID)
-			if err != nil {
-				log.Error(err, "error removing image", "given", imgDigestOrTag, "imageID", imageID, "name", idToImageMap[imageID])
-				continue
-			}
+		pattern, err := imagepattern.Parse(imgDigestOrTag)
+		if err != nil {
+			log.Error(err, "invalid image pattern", "given", imgDigestOrTag)
+			results = append(results, unversioned.ImageResult{Image: imgDigestOrTag, Result: unversioned.ImageError, Message: err.Error()})
+			continue
+		}
+		if pattern.Kind() != imagepattern.Literal {
+			matchers = append(matchers, pattern)
+			continue
+		}
 
-			deletedImages[imgDigestOrTag] = struct{}{}
-			log.Info("removed image", "given", imgDigestOrTag, "imageID", imageID, "name", idToImageMap[imageID])
-			removed++
+		normalized := imagepattern.Normalize(imgDigestOrTag)
+		imageID, isNonRunning := nonRunningImages[imgDigestOrTag]
+		if !isNonRunning {
+			imageID, isNonRunning = nonRunningImages[normalized]
+		}
+		if isNonRunning {
+			handled[imageID] = struct{}{}
+			remove(imgDigestOrTag, imageID, "")
 			continue
 		}
 
 		imageID, isRunning := runningImages[imgDigestOrTag]
+		if !isRunning {
+			imageID, isRunning = runningImages[normalized]
+		}
 		if isRunning {
+			handled[imageID] = struct{}{}
 			log.Info("image is running", "given", imgDigestOrTag, "imageID", imageID, "name", idToImageMap[imageID])
+			results = append(results, unversioned.ImageResult{Image: imgDigestOrTag, Result: unversioned.ImageRunning})
 			continue
 		}
 
 		log.Info("image is not on node", "given", imgDigestOrTag)
+		results = append(results, unversioned.ImageResult{Image: imgDigestOrTag, Result: unversioned.ImageNotPresent})
+	}
+
+	sort.SliceStable(matchers, func(i, j int) bool {
+		return matchers[i].Kind() < matchers[j].Kind()
+	})
+	for _, img := range allImages {
+		if _, ok := handled[img.ImageID]; ok || len(matchers) == 0 {
+			continue
+		}
+
+		names := append([]string{img.ImageID}, img.Names...)
+		names = append(names, img.Digests...)
+		for _, pattern := range matchers {
+			if !pattern.Match(names...) {
+				continue
+			}
+
+			handled[img.ImageID] = struct{}{}
+			if _, isRunning := runningImages[img.ImageID]; isRunning {
+				log.Info("image is running", "pattern", pattern.String(), "imageID", img.ImageID, "name", img.Names)
+				results = append(results, unversioned.ImageResult{Image: img.ImageID, Result: unversioned.ImageRunning, MatchedBy: pattern.String()})
+				break
+			}
+
+			remove(img.ImageID, img.ImageID, pattern.String())
+			break
+		}
 	}
 
 	if prune {
-		success := true
+		kept := map[string]struct{}{}
+		if *keepLast > 0 {
+			candidates := make([]unversioned.Image, 0, len(allImages))
+			for i := range allImages {
+				if _, isRunning := runningImages[allImages[i].ImageID]; !isRunning {
+					candidates = append(candidates, allImages[i])
+				}
+			}
+
+			kept, err = retention.Retain(backgroundContext, c, candidates, *keepLast)
+			if err != nil {
+				log.Error(err, "failed to get the creation time of images, they are kept")
+			}
+		}
+
+		// nonRunningImages holds each image under its ID, names and digests
+		checked := make(map[string]struct{})
 		for _, imageID := range nonRunningImages {
-			if _, deleted := deletedImages[imageID]; deleted {
+			if _, alreadyHandled := handled[imageID]; alreadyHandled {
 				continue
 			}
 
-			if util.IsExcluded(excluded, imageID, idToImageMap) {
-				log.Info("image is excluded", "imageID", imageID, "name", idToImageMap[imageID])
+			if _, alreadyChecked := checked[imageID]; alreadyChecked {
 				continue
 			}
+			checked[imageID] = struct{}{}
 
-			if err := c.DeleteImage(backgroundContext, imageID); err != nil {
-				success = false
-				log.Error(err, "error removing image", "imageID", imageID, "name", idToImageMap[imageID])
+			if _, ok := kept[imageID]; ok && !util.IsExcluded(excluded, imageID, idToImageMap) {
+				log.Info("image not removed, it is among the newest of its repository", "imageID", imageID, "name", idToImageMap[imageID])
+				results = append(results, unversioned.ImageResult{
+					Image:   imageID,
+					Result:  unversioned.ImageSkipped,
+					Message: fmt.Sprintf("one of the %d newest images of its repository", *keepLast),
+				})
 				continue
 			}
 
-			log.Info("removed image", "digest", imageID)
-			deletedImages[imageID] = struct{}{}
-			removed++
+			remove(imageID, imageID, "")
 		}
-		if success {
+	}
+
+	errs := d.wait()
+	// the runtime may have recovered from what made deletions fail
+	// transiently, such as a restart, by the time the others are done
+	if n := d.retryTransient(); n > 0 {
+		log.Info("retrying the images the runtime was unavailable to remove", "count", n)
+		errs = d.wait()
+	}
+	for index, err := range errs {
+		results[index].Result = unversioned.ImageError
+		results[index].Message = err.Error()
+		results[index].Size = 0
+	}
+
+	if prune {
+		if len(errs) == 0 {
 			log.Info("prune successful")
 		} else {
 			log.Info("error during prune")
 		}
 	}
 
-	return removed, nil
+	return results, nil
+}
+
+// summarize counts the results by outcome and adds up the bytes reclaimed, or
+// that would be reclaimed in a dry run.
+func summarize(results []unversioned.ImageResult) unversioned.NodeResult {
+	summary := unversioned.NodeResult{Images: results}
+
+	for _, result := range results {
+		switch result.Result {
+		case unversioned.ImageRemoved:
+			summary.Removed++
+			summary.ReclaimedBytes += result.Size
+		case unversioned.ImageWouldRemove:
+			summary.WouldRemove++
+			summary.WouldReclaimBytes += result.Size
+		case unversioned.ImageSkipped:
+			summary.Skipped++
+		case unversioned.ImageRunning:
+			summary.Running++
+		case unversioned.ImageExcluded:
+			summary.Excluded++
+			for _, pattern := range result.ExcludedBy {
+				if summary.Exclusions == nil {
+					summary.Exclusions = map[string]int64{}
+				}
+				summary.Exclusions[pattern]++
+			}
+		case unversioned.ImageNotPresent:
+			summary.NotPresent++
+		case unversioned.ImageError:
+			summary.Errors++
+		}
+	}
+
+	return summary
+}
+
+// addExclusionCounts adds the images protected from removal elsewhere, such as
+// by the collector, to the summary.
+func addExclusionCounts(summary *unversioned.NodeResult, counts map[string]int64) {
+	for pattern, count := range counts {
+		if summary.Exclusions == nil {
+			summary.Exclusions = map[string]int64{}
+		}
+		summary.Exclusions[pattern] += count
+	}
+}
+
+func measureImageFs(c cri.Remover) (imagefs.Usage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	return imagefs.Measure(ctx, c, util.ImageFsMountPath)
+}
+
+// lowThresholdReached returns a function that reports whether the image
+// filesystem is used below the low threshold. Dry runs free nothing, so their
+// usage is estimated from the sizes of the images that would be removed.
+func lowThresholdReached(before imagefs.Usage) func(freed int64) bool {
+	return func(freed int64) bool {
+		usage := before.Free(freed)
+		if !*dryRun {
+			measured, err := imagefs.Filesystem(util.ImageFsMountPath)
+			if err != nil {
+				log.Error(err, "failed to measure the image filesystem, estimating from the image sizes", "path", util.ImageFsMountPath)
+			} else {
+				usage = measured
+			}
+		}
+
+		return usage.Percent() < float64(*imageFsLow)
+	}
+}
+
+// imageFsUsage reports the usage of the image filesystem before and after
+// removal.
+func imageFsUsage(before, after imagefs.Usage) *unversioned.ImageFsUsage {
+	return &unversioned.ImageFsUsage{
+		CapacityBytes:    before.CapacityBytes,
+		UsedBytesBefore:  before.UsedBytes,
+		UsedBytesAfter:   after.UsedBytes,
+		ImageBytesBefore: before.ImageBytes,
+		ImageBytesAfter:  after.ImageBytes,
+	}
 }
@@ -18,6 +18,8 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/eraser-dev/eraser/pkg/cri"
+	"github.com/eraser-dev/eraser/pkg/imagefs"
+	"github.com/eraser-dev/eraser/pkg/imagepattern"
 	"github.com/eraser-dev/eraser/pkg/logger"
 	"github.com/eraser-dev/eraser/pkg/metrics"
 
@@ -29,11 +31,19 @@ var (
 	imageListPtr  = flag.String("imagelist", "", "name of ImageList")
 	enableProfile = flag.Bool("enable-pprof", false, "enable pprof profiling")
 	profilePort   = flag.Int("pprof-port", 6060, "port for pprof profiling. defaulted to 6060 if unspecified")
+	dryRun        = flag.Bool("dry-run", false, "report the images that would be removed without removing them")
+	keepLast      = flag.Int("keep-last", 0, "when pruning, keep the most recently created images of each repository that are not running, this many of them. 0 disables the policy")
+	imageFsHigh   = flag.Int("imagefs-high-threshold", 0, "measure the image filesystem before and after removing images. 0 disables the measurement")
+	imageFsLow    = flag.Int("imagefs-low-threshold", 0, "stop removing images once the image filesystem is used below this percentage")
+	concurrency   = flag.Int("concurrency", 1, "number of images deleted at once")
+	deletesPerSec = flag.Int("deletes-per-second", 0, "maximum number of image deletions started per second. 0 disables the limit")
+	timeout       = flag.Duration("timeout", 5*time.Minute, "deadline for listing and removing the images")
 
-	// Timeout  of connecting to server (default: 5m).
-	timeout  = 5 * time.Minute
 	log      = logf.Log.WithName("remover")
-	excluded map[string]struct{}
+	excluded *imagepattern.Set
+	// enoughFreed reports whether removal can stop, given the bytes freed so
+	// far. It is nil unless removal is gated on disk usage.
+	enoughFreed func(freed int64) bool
 )
 
 const (
@@ -59,7 +69,7 @@ func main() {
 		os.Exit(generalErr)
 	}
 
-	log.Info("remover starting", "imageListPtr", *imageListPtr, "criPath", util.CRIPath)
+	log.Info("remover starting", "imageListPtr", *imageListPtr, "criPath", util.CRIPath, "dryRun", *dryRun)
 
 	client, err := cri.NewRemoverClient(util.CRIPath)
 	if err != nil {
@@ -133,16 +143,54 @@ func main() {
 		log.Error(err, "failed to parse exclusion list")
 		os.Exit(generalErr)
 	}
-	if len(excluded) == 0 {
+	if excluded.Len() == 0 {
 		log.Info("no images to exclude")
 	}
 
-	removed, err := removeImages(client, imagelist)
+	var before *imagefs.Usage
+	if *imageFsHigh > 0 {
+		// like the collector, remove nothing when the usage is unknown rather
+		// than ignore the thresholds
+		usage, err := measureImageFs(client)
+		if err != nil {
+			log.Error(err, "failed to measure the image filesystem, no images will be removed", "path", util.ImageFsMountPath)
+			imagelist = nil
+		} else {
+			before = &usage
+			enoughFreed = lowThresholdReached(usage)
+		}
+	}
+
+	results, err := removeImages(client, imagelist)
 	if err != nil {
 		log.Error(err, "failed to remove images")
 		os.Exit(generalErr)
 	}
 
+	summary := summarize(results)
+	if before != nil {
+		after, err := measureImageFs(client)
+		if err != nil {
+			log.Error(err, "failed to measure the image filesystem after removal", "path", util.ImageFsMountPath)
+		} else {
+			summary.ImageFs = imageFsUsage(*before, after)
+			log.Info("image filesystem usage", "before", before.Percent(), "after", after.Percent(), "lowThreshold", *imageFsLow)
+		}
+	}
+	if *imageListPtr == "" {
+		// the collector leaves out the excluded images before they get here
+		counts, err := util.ReadExclusionCounts(util.ExclusionCountsPath)
+		if err != nil {
+			log.Error(err, "unable to read exclusion counts", "path", util.ExclusionCountsPath)
+		}
+		addExclusionCounts(&summary, counts)
+	}
+	log.Info("removal complete", "removed", summary.Removed, "wouldRemove", summary.WouldRemove, "skipped", summary.Skipped, "running", summary.Running, "excluded", summary.Excluded, "notPresent", summary.NotPresent, "errors", summary.Errors, "reclaimedBytes", summary.ReclaimedBytes, "wouldReclaimBytes", summary.WouldReclaimBytes)
+
+	if err := util.WriteNodeResult(summary); err != nil {
+		log.Error(err, "unable to report results", "path", util.TerminationMessagePath)
+	}
+
 	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" {
 		// record metrics
 		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
@@ -150,7 +198,7 @@ func main() {
 		exporter, reader, provider := metrics.ConfigureMetrics(ctx, log, os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"))
 		otel.SetMeterProvider(provider)
 
-		if err := metrics.RecordMetricsRemover(ctx, otel.GetMeterProvider(), int64(removed)); err != nil {
+		if err := metrics.RecordMetricsRemover(ctx, otel.GetMeterProvider(), summary.Removed, summary.ReclaimedBytes); err != nil {
 			log.Error(err, "error recording metrics")
 		}
 		metrics.ExportMetrics(log, exporter, reader)
@@ -1,9 +1,16 @@
 package main
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	"github.com/eraser-dev/eraser/pkg/imagepattern"
 )
 
 func TestRemoveImages(t *testing.T) {
@@ -85,3 +92,260 @@ func TestRemoveImages(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveImagesResults(t *testing.T) {
+	client := &testClient{
+		t:          t,
+		containers: []*v1.Container{{Image: &v1.ImageSpec{Image: "image1"}}},
+		images:     []*v1.Image{{Id: "image1"}, {Id: "image2"}, {Id: "image3"}},
+	}
+
+	var err error
+	excluded, err = imagepattern.NewSet([]string{"image3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { excluded = nil }()
+
+	results, err := removeImages(client, []string{"image1", "image2", "image3", "image4"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := map[string]unversioned.ImageResultType{
+		"image1": unversioned.ImageRunning,
+		"image2": unversioned.ImageRemoved,
+		"image3": unversioned.ImageExcluded,
+		"image4": unversioned.ImageNotPresent,
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("expected %d results, got %v", len(expected), results)
+	}
+	for _, result := range results {
+		if expected[result.Image] != result.Result {
+			t.Errorf("image %s: expected result %s, got %s", result.Image, expected[result.Image], result.Result)
+		}
+	}
+
+	summary := summarize(results)
+	if summary.Removed != 1 || summary.Running != 1 || summary.Excluded != 1 || summary.NotPresent != 1 || summary.Errors != 0 {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+	if summary.Exclusions["image3"] != 1 {
+		t.Errorf("expected image3 to be counted for its exclusion, got %v", summary.Exclusions)
+	}
+
+	addExclusionCounts(&summary, map[string]int64{"image3": 2, "docker.io/*": 1})
+	if summary.Exclusions["image3"] != 3 || summary.Exclusions["docker.io/*"] != 1 {
+		t.Errorf("unexpected exclusion counts: %v", summary.Exclusions)
+	}
+}
+
+func TestRemoveImagesDryRun(t *testing.T) {
+	client := &testClient{
+		t:          t,
+		containers: []*v1.Container{{Image: &v1.ImageSpec{Image: "image1"}}},
+		images:     []*v1.Image{{Id: "image1", Size_: 10}, {Id: "image2", Size_: 20}, {Id: "image3", Size_: 30}},
+	}
+
+	*dryRun = true
+	defer func() { *dryRun = false }()
+
+	results, err := removeImages(client, []string{"image2", "*"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(client.images) != 3 {
+		t.Errorf("expected no images to be removed, %d images left", len(client.images))
+	}
+
+	summary := summarize(results)
+	if summary.WouldRemove != 2 || summary.Removed != 0 {
+		t.Errorf("expected 2 images to be reported for removal, got %+v", summary)
+	}
+	if summary.WouldReclaimBytes != 50 || summary.ReclaimedBytes != 0 {
+		t.Errorf("expected 50 bytes to be reported as reclaimable, got %+v", summary)
+	}
+}
+
+func TestRemoveImagesPatterns(t *testing.T) {
+	client := &testClient{
+		t:          t,
+		containers: []*v1.Container{{Image: &v1.ImageSpec{Image: image3.Id}}},
+		images:     []*v1.Image{&image1, &image2, &image3, &image4, &image5},
+	}
+
+	var err error
+	excluded, err = imagepattern.NewSet([]string{"mcr.microsoft.com/oss/kubernetes/kube-proxy:*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { excluded = nil }()
+
+	results, err := removeImages(client, []string{
+		`/mcr\.microsoft\.com/.*/`,
+		"mcr.microsoft.com/**",
+		"mcr.microsoft.com/aks/acc/sgx-webhook:0.6",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := map[string]unversioned.ImageResult{
+		"mcr.microsoft.com/aks/acc/sgx-webhook:0.6": {Result: unversioned.ImageRemoved},
+		image2.Id: {Result: unversioned.ImageRemoved, MatchedBy: "mcr.microsoft.com/**"},
+		image3.Id: {Result: unversioned.ImageRunning, MatchedBy: "mcr.microsoft.com/**"},
+		image4.Id: {Result: unversioned.ImageExcluded, MatchedBy: "mcr.microsoft.com/**"},
+	}
+	if len(results) != len(expected) {
+		t.Fatalf("expected %d results, got %+v", len(expected), results)
+	}
+	for _, result := range results {
+		want, ok := expected[result.Image]
+		if !ok || want.Result != result.Result || want.MatchedBy != result.MatchedBy {
+			t.Errorf("unexpected result %+v", result)
+		}
+	}
+
+	if len(client.images) != 3 {
+		t.Errorf("expected 3 images to be left, got %d", len(client.images))
+	}
+}
+
+func TestRemoveImagesLowThreshold(t *testing.T) {
+	client := &testClient{
+		t: t,
+		images: []*v1.Image{
+			{Id: "image1", Size_: 40},
+			{Id: "image2", Size_: 80},
+			{Id: "image3", Size_: 20},
+		},
+	}
+
+	enoughFreed = func(freed int64) bool { return freed >= 100 }
+	defer func() { enoughFreed = nil }()
+
+	results, err := removeImages(client, []string{"image2", "image3", "image1"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	expected := map[string]unversioned.ImageResultType{
+		"image2": unversioned.ImageRemoved,
+		"image3": unversioned.ImageRemoved,
+		"image1": unversioned.ImageSkipped,
+	}
+	for _, result := range results {
+		if expected[result.Image] != result.Result {
+			t.Errorf("image %s: expected result %s, got %s", result.Image, expected[result.Image], result.Result)
+		}
+	}
+
+	if summary := summarize(results); summary.Removed != 2 || summary.Skipped != 1 || summary.ReclaimedBytes != 100 {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+	if len(client.images) != 1 || client.images[0].Id != "image1" {
+		t.Errorf("expected image1 to be left, got %v", client.images)
+	}
+}
+
+func TestRemoveImagesConcurrently(t *testing.T) {
+	errInUse := errors.New("image is in use")
+	client := &testClient{
+		t: t,
+		images: []*v1.Image{
+			{Id: "image1", Size_: 10},
+			{Id: "image2", Size_: 20},
+			{Id: "image3", Size_: 30},
+			{Id: "image4", Size_: 40},
+			{Id: "image5", Size_: 50},
+		},
+		deleteErrs: map[string]error{
+			"image3": errInUse,
+			// retried once the other deletions are done
+			"image4": status.Error(codes.Unavailable, "runtime restarting"),
+		},
+	}
+
+	*concurrency = 3
+	*deletesPerSec = 100
+	defer func() { *concurrency, *deletesPerSec = 1, 0 }()
+
+	targets := []string{"image1", "image2", "image3", "image4", "image5"}
+	results, err := removeImages(client, targets)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(results) != len(targets) {
+		t.Fatalf("expected %d results, got %+v", len(targets), results)
+	}
+	for i, result := range results {
+		if result.Image != targets[i] {
+			t.Errorf("expected result %d to be for %s, got %+v", i, targets[i], result)
+		}
+	}
+	if results[2].Result != unversioned.ImageError || results[2].Message != errInUse.Error() || results[2].Size != 0 {
+		t.Errorf("expected image3 to fail, got %+v", results[2])
+	}
+
+	if summary := summarize(results); summary.Removed != 4 || summary.Errors != 1 || summary.ReclaimedBytes != 120 {
+		t.Errorf("unexpected summary: %+v", summary)
+	}
+	if len(client.images) != 1 || client.images[0].Id != "image3" {
+		t.Errorf("expected image3 to be left, got %v", client.images)
+	}
+}
+
+func TestRemoveImagesKeepLast(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	client := &testClient{
+		t: t,
+		images: []*v1.Image{
+			{Id: "app1", RepoTags: []string{"registry.example.com/ci/app:1"}},
+			{Id: "app2", RepoTags: []string{"registry.example.com/ci/app:2"}},
+			{Id: "app3", RepoTags: []string{"registry.example.com/ci/app:3"}},
+			{Id: "app4", RepoTags: []string{"registry.example.com/ci/app:4"}},
+			{Id: "dangling"},
+		},
+		containers: []*v1.Container{
+			{Id: "c1", Image: &v1.ImageSpec{Image: "app4"}, ImageRef: "app4"},
+		},
+		created: map[string]time.Time{
+			"app1": start,
+			"app2": start.Add(time.Hour),
+			"app3": start.Add(2 * time.Hour),
+			"app4": start.Add(3 * time.Hour),
+		},
+	}
+
+	*keepLast = 1
+	defer func() { *keepLast = 0 }()
+
+	// an image given by name is removed even if it would be retained
+	results, err := removeImages(client, []string{"*", "registry.example.com/ci/app:2"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// the running image does not count towards the retained images
+	expected := map[string]unversioned.ImageResultType{
+		"registry.example.com/ci/app:2": unversioned.ImageRemoved,
+		"app1":                          unversioned.ImageRemoved,
+		"app3":                          unversioned.ImageSkipped,
+		"dangling":                      unversioned.ImageRemoved,
+	}
+	if len(results) != len(expected) {
+		t.Errorf("expected %d results, got %+v", len(expected), results)
+	}
+	for _, result := range results {
+		if expected[result.Image] != result.Result {
+			t.Errorf("image %s: expected result %s, got %s", result.Image, expected[result.Image], result.Result)
+		}
+	}
+
+	if len(client.images) != 2 {
+		t.Errorf("expected the running and the newest image to be left, got %v", client.images)
+	}
+}
@@ -3,6 +3,8 @@ package main
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,12 +19,20 @@ type testLogger interface {
 type testClient struct {
 	containers []*v1.Container
 	images     []*v1.Image
+	// creation times reported in the verbose image status, by image ID
+	created map[string]time.Time
+	// errors returned by the next deletion of images, by image ID
+	deleteErrs map[string]error
 	t          testLogger
 }
 
 var (
 	_ cri.Remover = &testClient{}
 
+	// testClientMu guards the images of test clients, which the remover
+	// deletes concurrently
+	testClientMu sync.Mutex
+
 	errImageNotRemoved = errors.New("image not removed")
 	errImageEmpty      = errors.New("unable to remove empty image")
 	timeoutTest        = 10 * time.Second
@@ -70,6 +80,9 @@ func (c *testClient) logf(format string, args ...interface{}) {
 }
 
 func (c *testClient) ListImages(_ context.Context) (list []*v1.Image, err error) {
+	testClientMu.Lock()
+	defer testClientMu.Unlock()
+
 	images := make([]*v1.Image, len(c.images))
 	copy(images, c.images)
 	return images, nil
@@ -81,6 +94,27 @@ func (c *testClient) ListContainers(_ context.Context) (list []*v1.Container, er
 	return containers, nil
 }
 
+func (c *testClient) ImageFsInfo(_ context.Context) ([]*v1.FilesystemUsage, error) {
+	testClientMu.Lock()
+	defer testClientMu.Unlock()
+
+	var used uint64
+	for _, img := range c.images {
+		used += img.Size_
+	}
+	return []*v1.FilesystemUsage{{UsedBytes: &v1.UInt64Value{Value: used}}}, nil
+}
+
+func (c *testClient) ImageStatus(_ context.Context, image string) (*v1.ImageStatusResponse, error) {
+	created, ok := c.created[image]
+	if !ok {
+		return &v1.ImageStatusResponse{}, nil
+	}
+
+	info := fmt.Sprintf(`{"imageSpec":{"created":%q}}`, created.Format(time.RFC3339))
+	return &v1.ImageStatusResponse{Info: map[string]string{"info": info}}, nil
+}
+
 func (c *testClient) removeImageFromSlice(index int) {
 	s := c.images
 	s = append(s[:index], s[index+1:]...)
@@ -88,10 +122,17 @@ func (c *testClient) removeImageFromSlice(index int) {
 }
 
 func (c *testClient) DeleteImage(_ context.Context, image string) (err error) {
+	testClientMu.Lock()
+	defer testClientMu.Unlock()
+
 	c.logf("DeleteImage: %s", image)
 	if image == "" {
 		return errImageEmpty
 	}
+	if err := c.deleteErrs[image]; err != nil {
+		delete(c.deleteErrs, image)
+		return err
+	}
 	for index, value := range c.images {
 		if value.Id == image {
 			c.removeImageFromSlice(index)
@@ -0,0 +1,105 @@
+// Package retention selects the images that a keep-last policy retains: the
+// most recently created images of each repository.
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/distribution/reference"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	"github.com/eraser-dev/eraser/pkg/cri"
+)
+
+// Retain reads the creation time of the images from the runtime, and returns
+// the IDs of the n most recently created images of each repository, as Keep
+// does. The creation times are set on the images. Images whose creation time
+// cannot be read are kept, and the errors are returned together.
+func Retain(ctx context.Context, c cri.Collector, images []unversioned.Image, n int) (map[string]struct{}, error) {
+	var errs []error
+	for i := range images {
+		created, err := cri.ImageCreated(ctx, c, images[i].ImageID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("image %s: %w", images[i].ImageID, err))
+			continue
+		}
+		if created != nil {
+			images[i].Created = &metav1.Time{Time: *created}
+		}
+	}
+
+	return Keep(images, n), errors.Join(errs...)
+}
+
+// Keep returns the IDs of the n most recently created images of each
+// repository. An image is kept if it is among the newest of any repository it
+// is tagged in. Images whose creation time is unknown count as the newest, so
+// that they are not removed by mistake, and images without tags belong to no
+// repository and are never kept.
+func Keep(images []unversioned.Image, n int) map[string]struct{} {
+	kept := make(map[string]struct{})
+	if n <= 0 {
+		return kept
+	}
+
+	byRepository := make(map[string][]*unversioned.Image)
+	for i := range images {
+		for _, repository := range Repositories(&images[i]) {
+			byRepository[repository] = append(byRepository[repository], &images[i])
+		}
+	}
+
+	for _, repoImages := range byRepository {
+		sort.SliceStable(repoImages, func(i, j int) bool {
+			return newer(repoImages[i], repoImages[j])
+		})
+
+		for i := 0; i < len(repoImages) && i < n; i++ {
+			kept[repoImages[i].ImageID] = struct{}{}
+		}
+	}
+
+	return kept
+}
+
+// Repositories returns the repositories the image is tagged in.
+func Repositories(img *unversioned.Image) []string {
+	seen := make(map[string]struct{}, len(img.Names))
+	repositories := make([]string, 0, len(img.Names))
+	for _, name := range img.Names {
+		named, err := reference.ParseNormalizedNamed(name)
+		if err != nil {
+			continue
+		}
+
+		repository := named.Name()
+		if _, ok := seen[repository]; ok {
+			continue
+		}
+		seen[repository] = struct{}{}
+		repositories = append(repositories, repository)
+	}
+
+	return repositories
+}
+
+// newer tells whether a was created after b. Unknown creation times come
+// first, and images created at the same time are ordered by ID.
+func newer(a, b *unversioned.Image) bool {
+	switch {
+	case a.Created == nil && b.Created == nil:
+		return a.ImageID < b.ImageID
+	case a.Created == nil:
+		return true
+	case b.Created == nil:
+		return false
+	case !a.Created.Equal(b.Created):
+		return a.Created.After(b.Created.Time)
+	default:
+		return a.ImageID < b.ImageID
+	}
+}
@@ -0,0 +1,114 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+)
+
+type fakeCollector struct {
+	created map[string]time.Time
+}
+
+func (f *fakeCollector) ListImages(context.Context) ([]*v1.Image, error) {
+	return nil, nil
+}
+
+func (f *fakeCollector) ListContainers(context.Context) ([]*v1.Container, error) {
+	return nil, nil
+}
+
+func (f *fakeCollector) ImageFsInfo(context.Context) ([]*v1.FilesystemUsage, error) {
+	return nil, nil
+}
+
+func (f *fakeCollector) ImageStatus(_ context.Context, image string) (*v1.ImageStatusResponse, error) {
+	created, ok := f.created[image]
+	if !ok {
+		return nil, errors.New("image not found")
+	}
+
+	info := fmt.Sprintf(`{"imageSpec":{"created":%q}}`, created.Format(time.RFC3339))
+	return &v1.ImageStatusResponse{Info: map[string]string{"info": info}}, nil
+}
+
+func TestKeep(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	created := func(days int) *metav1.Time {
+		return &metav1.Time{Time: start.AddDate(0, 0, days)}
+	}
+
+	images := []unversioned.Image{
+		{ImageID: "sha256:app1", Names: []string{"registry.example.com/ci/app:1"}, Created: created(1)},
+		{ImageID: "sha256:app2", Names: []string{"registry.example.com/ci/app:2"}, Created: created(2)},
+		{ImageID: "sha256:app3", Names: []string{"registry.example.com/ci/app:3", "registry.example.com/ci/app:latest"}, Created: created(3)},
+		// the oldest of its repository, but the newest of another one
+		{ImageID: "sha256:app0", Names: []string{"registry.example.com/ci/app:0", "registry.example.com/ci/base:0"}, Created: created(0)},
+		{ImageID: "sha256:nginx-old", Names: []string{"nginx:1.24"}, Created: created(0)},
+		{ImageID: "sha256:nginx-new", Names: []string{"docker.io/library/nginx:1.25"}, Created: created(5)},
+		{ImageID: "sha256:nginx-unknown", Names: []string{"nginx:unknown"}},
+		{ImageID: "sha256:dangling"},
+	}
+
+	kept := Keep(images, 2)
+
+	expected := []string{"sha256:app3", "sha256:app2", "sha256:app0", "sha256:nginx-unknown", "sha256:nginx-new"}
+	for _, id := range expected {
+		if _, ok := kept[id]; !ok {
+			t.Errorf("expected %s to be kept", id)
+		}
+	}
+	if len(kept) != len(expected) {
+		t.Errorf("expected %d images to be kept, got %v", len(expected), kept)
+	}
+
+	if kept := Keep(images, 0); len(kept) != 0 {
+		t.Errorf("expected a disabled policy to keep no images, got %v", kept)
+	}
+}
+
+func TestRepositories(t *testing.T) {
+	img := unversioned.Image{Names: []string{"nginx:1.25", "docker.io/library/nginx:latest", "quay.io/org/nginx:1.25"}}
+
+	repositories := Repositories(&img)
+	if len(repositories) != 2 || repositories[0] != "docker.io/library/nginx" || repositories[1] != "quay.io/org/nginx" {
+		t.Errorf("unexpected repositories %v", repositories)
+	}
+}
+
+func TestRetain(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &fakeCollector{created: map[string]time.Time{
+		"sha256:old": start,
+		"sha256:new": start.Add(time.Hour),
+	}}
+
+	images := []unversioned.Image{
+		{ImageID: "sha256:old", Names: []string{"app:old"}},
+		{ImageID: "sha256:new", Names: []string{"app:new"}},
+		{ImageID: "sha256:missing", Names: []string{"app:missing"}},
+	}
+
+	kept, err := Retain(context.Background(), c, images, 2)
+	if err == nil {
+		t.Error("expected an error for the missing image")
+	}
+
+	// the image without a creation time counts as the newest
+	if _, ok := kept["sha256:missing"]; !ok || len(kept) != 2 {
+		t.Errorf("expected the missing and the newest image to be kept, got %v", kept)
+	}
+	if _, ok := kept["sha256:new"]; !ok {
+		t.Errorf("expected the newest image to be kept, got %v", kept)
+	}
+	if images[0].Created == nil || !images[0].Created.Time.Equal(start) {
+		t.Errorf("expected the creation time to be set, got %v", images[0].Created)
+	}
+}
@@ -80,6 +80,12 @@ func (cfg *config) ReceiveImages() ([]unversioned.Image, error) {
 }
 
 func (cfg *config) SendImages(nonCompliantImages, failedImages []unversioned.Image) error {
+	// the manager reads the result to keep its metrics
+	result := util.ScanResult{Vulnerable: int64(len(nonCompliantImages)), Failed: int64(len(failedImages))}
+	if err := util.WriteScanResult(result); err != nil {
+		cfg.log.Error(err, "unable to report scan result", "path", util.TerminationMessagePath)
+	}
+
 	if cfg.deleteScanFailedImages {
 		nonCompliantImages = append(nonCompliantImages, failedImages...)
 	}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	trivyTypes "github.com/aquasecurity/trivy/pkg/types"
+	"github.com/distribution/reference"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	"github.com/eraser-dev/eraser/pkg/imagepattern"
+)
+
+const expiryDateFormat = "2006-01-02"
+
+// allowlist suppresses the vulnerabilities whose risk has been accepted, so
+// that they do not make images non-compliant.
+type allowlist []allowRule
+
+type allowRule struct {
+	Suppression
+	ids          map[string]struct{}
+	packages     map[string]struct{}
+	repositories []*imagepattern.Pattern
+	// nil if the rule does not expire
+	expires *time.Time
+}
+
+// newAllowlist compiles the suppression rules of the configuration.
+func newAllowlist(suppressions []Suppression) (allowlist, error) {
+	var errs []error
+	list := make(allowlist, 0, len(suppressions))
+
+	for i := range suppressions {
+		rule, err := newAllowRule(&suppressions[i])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("allowlist[%d]: %w", i, err))
+			continue
+		}
+		list = append(list, rule)
+	}
+
+	return list, errors.Join(errs...)
+}
+
+func newAllowRule(s *Suppression) (allowRule, error) {
+	rule := allowRule{Suppression: *s}
+
+	if len(s.IDs) == 0 && len(s.Packages) == 0 {
+		return rule, errors.New("ids or packages must be set")
+	}
+
+	rule.ids = toSet(s.IDs)
+	rule.packages = toSet(s.Packages)
+
+	for _, repo := range s.Repositories {
+		pattern, err := imagepattern.Parse(repo)
+		if err != nil {
+			return rule, fmt.Errorf("invalid repository %q: %w", repo, err)
+		}
+		rule.repositories = append(rule.repositories, pattern)
+	}
+
+	if s.Expires != "" {
+		expires, err := parseExpiry(s.Expires)
+		if err != nil {
+			return rule, err
+		}
+		rule.expires = &expires
+	}
+
+	return rule, nil
+}
+
+// parseExpiry parses a date, from which a rule no longer applies, or a time.
+func parseExpiry(s string) (time.Time, error) {
+	if t, err := time.Parse(expiryDateFormat, s); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expires %q: must be a date such as 2024-12-31, or an RFC 3339 time", s)
+	}
+
+	return t, nil
+}
+
+func toSet(values []string) map[string]struct{} {
+	if len(values) == 0 {
+		return nil
+	}
+
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+// suppresses returns the rule suppressing a vulnerability of an image, if
+// any. Rules that expired are ignored, and logged when they would have
+// applied.
+func (l allowlist) suppresses(img unversioned.Image, vuln *trivyTypes.DetectedVulnerability, now time.Time) *allowRule {
+	for i := range l {
+		rule := &l[i]
+		if !rule.matches(img, vuln) {
+			continue
+		}
+
+		if rule.expires != nil && !now.Before(*rule.expires) {
+			log.Info("allowlist rule expired, vulnerability not suppressed", "imageID", img.ImageID, "vulnerabilityID", vuln.VulnerabilityID, "package", vuln.PkgName, "expires", rule.Expires, "reason", rule.Reason)
+			continue
+		}
+
+		return rule
+	}
+
+	return nil
+}
+
+func (r *allowRule) matches(img unversioned.Image, vuln *trivyTypes.DetectedVulnerability) bool {
+	if r.ids != nil {
+		if _, ok := r.ids[vuln.VulnerabilityID]; !ok {
+			return false
+		}
+	}
+
+	if r.packages != nil {
+		if _, ok := r.packages[vuln.PkgName]; !ok {
+			return false
+		}
+	}
+
+	if len(r.repositories) == 0 {
+		return true
+	}
+
+	names := repositories(img)
+	for _, pattern := range r.repositories {
+		if pattern.Match(names...) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// repositories returns the names of an image, along with the repositories
+// they are in, so that a repository matches every tag and digest in it.
+func repositories(img unversioned.Image) []string {
+	names := make([]string, 0, 2*(len(img.Names)+len(img.Digests)))
+	for _, name := range append(append([]string{}, img.Names...), img.Digests...) {
+		names = append(names, name)
+
+		named, err := reference.ParseNormalizedNamed(name)
+		if err != nil {
+			continue
+		}
+		names = append(names, reference.TrimNamed(named).String())
+	}
+
+	return names
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	trivyTypes "github.com/aquasecurity/trivy/pkg/types"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+)
+
+func TestNewAllowlist(t *testing.T) {
+	cases := map[string]struct {
+		suppression Suppression
+		wantErr     bool
+	}{
+		"ids":              {suppression: Suppression{IDs: []string{"CVE-2023-0001"}}},
+		"packages":         {suppression: Suppression{Packages: []string{"openssl"}, Expires: "2024-12-31"}},
+		"expiry time":      {suppression: Suppression{IDs: []string{"CVE-2023-0001"}, Expires: "2024-12-31T12:00:00Z"}},
+		"nothing to match": {suppression: Suppression{Repositories: []string{"docker.io/library/*"}}, wantErr: true},
+		"invalid expiry":   {suppression: Suppression{IDs: []string{"CVE-2023-0001"}, Expires: "next year"}, wantErr: true},
+		"invalid pattern":  {suppression: Suppression{IDs: []string{"CVE-2023-0001"}, Repositories: []string{"/[/"}}, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := newAllowlist([]Suppression{tc.suppression})
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestVulnerable(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	allowlist, err := newAllowlist([]Suppression{
+		{IDs: []string{"CVE-2023-0001"}, Reason: "not exploitable"},
+		{Packages: []string{"openssl"}, Repositories: []string{"registry.example.com/team/*"}, Expires: "2024-12-31"},
+		{IDs: []string{"CVE-2023-0002"}, Expires: "2024-01-01"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &ImageScanner{allowlist: allowlist}
+
+	teamImage := unversioned.Image{ImageID: "sha256:team", Names: []string{"registry.example.com/team/app:v1"}}
+	otherImage := unversioned.Image{ImageID: "sha256:other", Names: []string{"nginx:1.25"}}
+
+	report := func(vulns ...trivyTypes.DetectedVulnerability) *trivyTypes.Report {
+		return &trivyTypes.Report{Results: trivyTypes.Results{{Vulnerabilities: vulns}}}
+	}
+
+	cases := map[string]struct {
+		img            unversioned.Image
+		report         *trivyTypes.Report
+		wantVulnerable bool
+		wantValidUntil bool
+	}{
+		"no vulnerabilities": {
+			img:    otherImage,
+			report: report(),
+		},
+		"suppressed by id": {
+			img:    otherImage,
+			report: report(trivyTypes.DetectedVulnerability{VulnerabilityID: "CVE-2023-0001", PkgName: "zlib"}),
+		},
+		"suppressed by package in repository": {
+			img:            teamImage,
+			report:         report(trivyTypes.DetectedVulnerability{VulnerabilityID: "CVE-2023-0003", PkgName: "openssl"}),
+			wantValidUntil: true,
+		},
+		"package in another repository": {
+			img:            otherImage,
+			report:         report(trivyTypes.DetectedVulnerability{VulnerabilityID: "CVE-2023-0003", PkgName: "openssl"}),
+			wantVulnerable: true,
+		},
+		"expired": {
+			img:            otherImage,
+			report:         report(trivyTypes.DetectedVulnerability{VulnerabilityID: "CVE-2023-0002", PkgName: "zlib"}),
+			wantVulnerable: true,
+		},
+		"one not suppressed": {
+			img: teamImage,
+			report: report(
+				trivyTypes.DetectedVulnerability{VulnerabilityID: "CVE-2023-0001", PkgName: "zlib"},
+				trivyTypes.DetectedVulnerability{VulnerabilityID: "CVE-2023-0004", PkgName: "curl"},
+			),
+			wantVulnerable: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			vulnerable, validUntil := s.vulnerable(tc.img, tc.report, now)
+			if vulnerable != tc.wantVulnerable {
+				t.Errorf("expected vulnerable %v, got %v", tc.wantVulnerable, vulnerable)
+			}
+			if (validUntil != nil) != tc.wantValidUntil {
+				t.Errorf("expected a validity limit %v, got %v", tc.wantValidUntil, validUntil)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aquasecurity/trivy-db/pkg/metadata"
+)
+
+// scanCache keeps the verdicts of the images scanned on a node on the host,
+// so that the images are not scanned again on the next runs. A verdict is
+// reused for the same image, scanned with the same vulnerability database and
+// the same configuration.
+type scanCache struct {
+	path       string
+	dbVersion  string
+	configHash string
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	hits    int
+}
+
+// cacheEntry is the verdict of an image, stored under its ID.
+type cacheEntry struct {
+	DBVersion  string     `json:"dbVersion"`
+	ConfigHash string     `json:"configHash"`
+	Status     ScanStatus `json:"status"`
+	ScannedAt  time.Time  `json:"scannedAt"`
+	// when the verdict may change, as a suppression expires or a
+	// vulnerability becomes old enough to count
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+// loadScanCache reads the cache saved to path, leaving out the verdicts given
+// by another database or configuration. A missing file results in an empty
+// cache.
+func loadScanCache(path, dbVersion, configHash string) (*scanCache, error) {
+	c := &scanCache{
+		path:       path,
+		dbVersion:  dbVersion,
+		configHash: configHash,
+		entries:    map[string]cacheEntry{},
+	}
+
+	//nolint:gosec // G304: Reading the cache file is intended functionality
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries map[string]cacheEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	for id, entry := range entries {
+		if entry.DBVersion == dbVersion && entry.ConfigHash == configHash {
+			c.entries[id] = entry
+		}
+	}
+
+	return c, nil
+}
+
+// get returns the verdict cached for an image, unless it is no longer valid.
+func (c *scanCache) get(imageID string, now time.Time) (ScanStatus, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[imageID]
+	if !ok || (entry.ValidUntil != nil && !now.Before(*entry.ValidUntil)) {
+		return StatusFailed, false
+	}
+
+	c.hits++
+	return entry.Status, true
+}
+
+// put caches the verdict of an image, valid until the given time if any.
+// Failed scans are not cached, so that they are tried again.
+func (c *scanCache) put(imageID string, status ScanStatus, validUntil *time.Time, now time.Time) {
+	if imageID == "" || status == StatusFailed {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[imageID] = cacheEntry{
+		DBVersion:  c.dbVersion,
+		ConfigHash: c.configHash,
+		Status:     status,
+		ScannedAt:  now,
+		ValidUntil: validUntil,
+	}
+}
+
+// save writes the cache to its path. The file is replaced atomically, so an
+// interrupted save leaves the previous cache in place.
+func (c *scanCache) save() error {
+	c.mu.Lock()
+	data, err := json.Marshal(c.entries)
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o750); err != nil {
+		return err
+	}
+
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, c.path)
+}
+
+// hitCount returns how many verdicts were reused.
+func (c *scanCache) hitCount() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.hits
+}
+
+// openScanCache updates the vulnerability database, to know its version, and
+// loads the verdicts cached on the host for it and the configuration. The
+// update takes at most the per-image timeout, and stops at the deadline of ctx.
+func openScanCache(ctx context.Context, cfg *Config, path string) (*scanCache, error) {
+	if cfg.CacheDir == "" {
+		return nil, errors.New("cacheDir is not set")
+	}
+
+	if perImage := time.Duration(cfg.Timeout.PerImage); perImage > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, perImage)
+		defer cancel()
+	}
+
+	dbVersion, err := downloadDB(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to update the vulnerability database: %w", err)
+	}
+
+	hash, err := configHash(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadScanCache(path, dbVersion, hash)
+}
+
+// configHash hashes the parts of the configuration that decide the verdict of
+// an image.
+func configHash(cfg *Config) (string, error) {
+	verdictCfg := *cfg
+	verdictCfg.Runtime = DefaultConfig().Runtime
+	verdictCfg.CacheDir = ""
+	verdictCfg.DeleteFailedImages = false
+	verdictCfg.Concurrency = 0
+	verdictCfg.CacheResults = false
+	verdictCfg.Timeout = TimeoutConfig{}
+
+	data, err := json.Marshal(verdictCfg)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// downloadDB updates the vulnerability database in the cache directory, and
+// returns its version.
+func downloadDB(ctx context.Context, cfg *Config) (string, error) {
+	stderr := new(bytes.Buffer)
+
+	//nolint:gosec // G204: Trivy subprocess execution is intended functionality
+	cmd := exec.CommandContext(ctx, trivyCommandName, dbArgs(cfg)...)
+	cmd.Stderr = stderr
+	cmd.Env = os.Environ()
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	meta, err := metadata.NewClient(filepath.Join(cfg.CacheDir, "db")).Get()
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%d-%s", meta.Version, meta.UpdatedAt.UTC().Format(time.RFC3339)), nil
+}
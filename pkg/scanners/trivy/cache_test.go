@@ -0,0 +1,90 @@
+package main
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestScanCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "scan-cache.json")
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	cache, err := loadScanCache(path, "2-2024-03-01T06:00:00Z", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cache.put("image1", StatusNonCompliant, nil, now)
+	cache.put("image2", StatusOK, nil, now)
+	cache.put("image3", StatusFailed, nil, now)
+	expires := now.Add(time.Hour)
+	cache.put("image4", StatusOK, &expires, now)
+	if err := cache.save(); err != nil {
+		t.Fatal(err)
+	}
+
+	cache, err = loadScanCache(path, "2-2024-03-01T06:00:00Z", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status, ok := cache.get("image1", now); !ok || status != StatusNonCompliant {
+		t.Errorf("expected image1 to be cached as non-compliant, got %v, %v", status, ok)
+	}
+	if status, ok := cache.get("image2", now); !ok || status != StatusOK {
+		t.Errorf("expected image2 to be cached as compliant, got %v, %v", status, ok)
+	}
+	if _, ok := cache.get("image3", now); ok {
+		t.Error("expected failed scans not to be cached")
+	}
+	if _, ok := cache.get("image4", now); !ok {
+		t.Error("expected image4 to be cached until its suppression expires")
+	}
+	if _, ok := cache.get("image4", expires); ok {
+		t.Error("expected the verdict of image4 to expire with its suppression")
+	}
+	if cache.hitCount() != 3 {
+		t.Errorf("expected 3 hits, got %d", cache.hitCount())
+	}
+
+	cache, err = loadScanCache(path, "2-2024-03-01T12:00:00Z", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.get("image1", now); ok {
+		t.Error("expected verdicts of another database to be dropped")
+	}
+
+	cache, err = loadScanCache(path, "2-2024-03-01T06:00:00Z", "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.get("image1", now); ok {
+		t.Error("expected verdicts of another configuration to be dropped")
+	}
+}
+
+func TestConfigHash(t *testing.T) {
+	hash := func(cfg *Config) string {
+		h, err := configHash(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+
+	base := hash(DefaultConfig())
+
+	cfg := DefaultConfig()
+	cfg.Concurrency = 4
+	cfg.Timeout.Total = testDuration
+	cfg.DeleteFailedImages = false
+	if got := hash(cfg); got != base {
+		t.Error("expected settings that do not decide verdicts to be left out of the hash")
+	}
+
+	cfg = DefaultConfig()
+	cfg.Vulnerabilities.Severities = []string{severityCritical}
+	if got := hash(cfg); got == base {
+		t.Error("expected the severities to change the hash")
+	}
+}
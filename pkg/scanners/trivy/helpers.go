@@ -35,5 +35,15 @@ func loadConfig(filename string) (Config, error) {
 		return cfg, err
 	}
 
+	if _, err := newAllowlist(cfg.Allowlist); err != nil {
+		log.Error(err, "invalid allowlist")
+		return cfg, err
+	}
+
+	if err := validatePolicy(&cfg.Policy); err != nil {
+		log.Error(err, "invalid policy")
+		return cfg, err
+	}
+
 	return cfg, nil
 }
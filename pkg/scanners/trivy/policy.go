@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	trivyTypes "github.com/aquasecurity/trivy/pkg/types"
+)
+
+// validatePolicy checks the thresholds of the policy.
+func validatePolicy(p *PolicyConfig) error {
+	var errs []error
+
+	if p.MinCVSSScore < 0 || p.MinCVSSScore > 10 {
+		errs = append(errs, fmt.Errorf("invalid policy.minCVSSScore %v: must be between 0 and 10", p.MinCVSSScore))
+	}
+
+	if p.MinPublishedAge < 0 {
+		errs = append(errs, fmt.Errorf("invalid policy.minPublishedAge %v: must not be negative", time.Duration(p.MinPublishedAge)))
+	}
+
+	for severity, n := range p.MaxCount {
+		switch severity {
+		case severityCritical, severityHigh, severityMedium, severityLow, severityUnknown:
+		default:
+			errs = append(errs, fmt.Errorf("invalid policy.maxCount severity %q: valid severities are %s, %s, %s, %s and %s",
+				severity, severityCritical, severityHigh, severityMedium, severityLow, severityUnknown))
+		}
+		if n < 0 {
+			errs = append(errs, fmt.Errorf("invalid policy.maxCount.%s %d: must not be negative", severity, n))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// policyCounts reports whether a vulnerability counts toward making an image
+// non-compliant. A vulnerability that does not count only because it was
+// published too recently counts from the returned time.
+func policyCounts(p *PolicyConfig, vuln *trivyTypes.DetectedVulnerability, now time.Time) (bool, *time.Time) {
+	if p.FixedOnly && vuln.FixedVersion == "" {
+		return false, nil
+	}
+
+	if p.MinCVSSScore > 0 && cvssScore(vuln) < p.MinCVSSScore {
+		return false, nil
+	}
+
+	if minAge := time.Duration(p.MinPublishedAge); minAge > 0 {
+		if vuln.PublishedDate == nil {
+			return false, nil
+		}
+		if from := vuln.PublishedDate.Add(minAge); now.Before(from) {
+			return false, &from
+		}
+	}
+
+	return true, nil
+}
+
+// policyExceeded reports whether an image with the given number of counted
+// vulnerabilities of a severity is non-compliant.
+func policyExceeded(p *PolicyConfig, severity string, count int) bool {
+	maxCount, ok := p.MaxCount[severity]
+	return !ok || count > maxCount
+}
+
+// cvssScore returns the highest CVSS score given to a vulnerability, of the
+// most recent version of CVSS each source scored it with. Zero means it has no
+// score.
+func cvssScore(vuln *trivyTypes.DetectedVulnerability) float64 {
+	var score float64
+	for _, cvss := range vuln.CVSS {
+		s := cvss.V40Score
+		if s == 0 {
+			s = cvss.V3Score
+		}
+		if s == 0 {
+			s = cvss.V2Score
+		}
+		score = max(score, s)
+	}
+
+	return score
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	dbTypes "github.com/aquasecurity/trivy-db/pkg/types"
+	trivyTypes "github.com/aquasecurity/trivy/pkg/types"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	cases := map[string]struct {
+		policy  PolicyConfig
+		wantErr bool
+	}{
+		"empty":             {},
+		"thresholds":        {policy: PolicyConfig{MinCVSSScore: 9, FixedOnly: true, MinPublishedAge: unversioned.Duration(720 * time.Hour), MaxCount: map[string]int{severityHigh: 5}}},
+		"score too high":    {policy: PolicyConfig{MinCVSSScore: 11}, wantErr: true},
+		"negative score":    {policy: PolicyConfig{MinCVSSScore: -1}, wantErr: true},
+		"negative age":      {policy: PolicyConfig{MinPublishedAge: unversioned.Duration(-time.Hour)}, wantErr: true},
+		"unknown severity":  {policy: PolicyConfig{MaxCount: map[string]int{"high": 5}}, wantErr: true},
+		"negative maxCount": {policy: PolicyConfig{MaxCount: map[string]int{severityHigh: -1}}, wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validatePolicy(&tc.policy)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestVulnerablePolicy(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	img := unversioned.Image{ImageID: "sha256:app", Names: []string{"nginx:1.25"}}
+	longAgo := now.AddDate(-1, 0, 0)
+	recently := now.AddDate(0, 0, -10)
+
+	vuln := func(severity string, score float64, fixed string, published *time.Time) trivyTypes.DetectedVulnerability {
+		v := trivyTypes.DetectedVulnerability{VulnerabilityID: "CVE-2024-0001", PkgName: "openssl", FixedVersion: fixed}
+		v.Severity = severity
+		v.PublishedDate = published
+		if score > 0 {
+			v.CVSS = dbTypes.VendorCVSS{"nvd": {V3Score: score}}
+		}
+		return v
+	}
+	report := func(vulns ...trivyTypes.DetectedVulnerability) *trivyTypes.Report {
+		return &trivyTypes.Report{Results: trivyTypes.Results{{Vulnerabilities: vulns}}}
+	}
+
+	cases := map[string]struct {
+		policy         PolicyConfig
+		report         *trivyTypes.Report
+		wantVulnerable bool
+		wantValidUntil *time.Time
+	}{
+		"any vulnerability by default": {
+			report:         report(vuln(severityLow, 0, "", nil)),
+			wantVulnerable: true,
+		},
+		"score below threshold": {
+			policy: PolicyConfig{MinCVSSScore: 9},
+			report: report(vuln(severityHigh, 8.8, "1.1", nil), vuln(severityHigh, 0, "1.1", nil)),
+		},
+		"score at threshold": {
+			policy:         PolicyConfig{MinCVSSScore: 9},
+			report:         report(vuln(severityCritical, 9, "1.1", nil)),
+			wantVulnerable: true,
+		},
+		"count within maxCount": {
+			policy: PolicyConfig{MaxCount: map[string]int{severityHigh: 2}},
+			report: report(vuln(severityHigh, 0, "", nil), vuln(severityHigh, 0, "", nil)),
+		},
+		"count above maxCount": {
+			policy:         PolicyConfig{MaxCount: map[string]int{severityHigh: 2}},
+			report:         report(vuln(severityHigh, 0, "", nil), vuln(severityHigh, 0, "", nil), vuln(severityHigh, 0, "", nil)),
+			wantVulnerable: true,
+		},
+		"severity without maxCount": {
+			policy:         PolicyConfig{MaxCount: map[string]int{severityHigh: 2}},
+			report:         report(vuln(severityCritical, 0, "", nil)),
+			wantVulnerable: true,
+		},
+		"not fixed": {
+			policy: PolicyConfig{FixedOnly: true},
+			report: report(vuln(severityCritical, 0, "", nil)),
+		},
+		"fixed and published long ago": {
+			policy:         PolicyConfig{FixedOnly: true, MinPublishedAge: unversioned.Duration(30 * 24 * time.Hour)},
+			report:         report(vuln(severityCritical, 0, "1.1", &longAgo)),
+			wantVulnerable: true,
+		},
+		"published recently": {
+			policy:         PolicyConfig{MinPublishedAge: unversioned.Duration(30 * 24 * time.Hour)},
+			report:         report(vuln(severityCritical, 0, "1.1", &recently), vuln(severityHigh, 0, "1.1", nil)),
+			wantValidUntil: ptr(recently.Add(30 * 24 * time.Hour)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s := &ImageScanner{config: Config{Policy: tc.policy}}
+			vulnerable, validUntil := s.vulnerable(img, tc.report, now)
+			if vulnerable != tc.wantVulnerable {
+				t.Errorf("expected vulnerable %v, got %v", tc.wantVulnerable, vulnerable)
+			}
+			if (validUntil == nil) != (tc.wantValidUntil == nil) || (validUntil != nil && !validUntil.Equal(*tc.wantValidUntil)) {
+				t.Errorf("expected validity limit %v, got %v", tc.wantValidUntil, validUntil)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
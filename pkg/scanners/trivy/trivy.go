@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/eraser-dev/eraser/api/unversioned"
@@ -43,6 +45,10 @@ const (
 	statusWillNotFix         = "will_not_fix"
 	statusFixDeferred        = "fix_deferred"
 	statusEndOfLife          = "end_of_life"
+
+	// memory a trivy process needs to scan an image, which bounds the number
+	// of images scanned at once
+	scanMemory = 512 * 1024 * 1024
 )
 
 var (
@@ -54,6 +60,8 @@ var (
 
 	// This can be overwritten by the linker.
 	trivyVersion = "dev"
+
+	errTotalTimeout = errors.New("image scan total timeout exceeded")
 )
 
 func main() {
@@ -104,16 +112,41 @@ func main() {
 		os.Exit(generalErr)
 	}
 
-	s, err := initScanner(&userConfig)
+	// the total timeout also covers updating the vulnerability database when
+	// opening the scan cache.
+	deadline := time.Now().Add(time.Duration(userConfig.Timeout.Total))
+
+	var cache *scanCache
+	if userConfig.CacheResults {
+		cacheCtx, cancel := context.WithDeadline(ctx, deadline)
+		cache, err = openScanCache(cacheCtx, &userConfig, utils.ScanCachePath)
+		cancel()
+		if err != nil {
+			log.Error(err, "unable to open the scan cache, all images will be scanned")
+		}
+	}
+
+	s, err := initScanner(&userConfig, cache, deadline)
 	if err != nil {
 		log.Error(err, "error initializing scanner")
+		os.Exit(generalErr)
 	}
 
-	vulnerableImages, failedImages, err := scan(s, allImages)
+	workers := scanWorkers(userConfig.Concurrency)
+	log.Info("scanning images", "count", len(allImages), "workers", workers)
+
+	vulnerableImages, failedImages, err := scan(s, allImages, workers)
 	if err != nil {
 		log.Error(err, "total image scan timed out")
 	}
 
+	if cache != nil {
+		log.Info("scan cache", "reused", cache.hitCount(), "images", len(allImages))
+		if err := cache.save(); err != nil {
+			log.Error(err, "unable to save the scan cache", "path", utils.ScanCachePath)
+		}
+	}
+
 	log.Info("Vulnerable", "Images", vulnerableImages, "Total count", len(vulnerableImages))
 
 	if len(failedImages) > 0 {
@@ -143,7 +176,9 @@ func runProfileServer() {
 	log.Error(err, "pprof server failed")
 }
 
-func initScanner(userConfig *Config) (Scanner, error) {
+// initScanner creates the scanner. Scanning stops at the given deadline, which
+// the total timeout was counted from.
+func initScanner(userConfig *Config, cache *scanCache, deadline time.Time) (Scanner, error) {
 	if userConfig == nil {
 		return nil, fmt.Errorf("invalid trivy scanner config")
 	}
@@ -155,44 +190,114 @@ func initScanner(userConfig *Config) (Scanner, error) {
 		Address: utils.CRIPath,
 	}
 
-	totalTimeout := time.Duration(userConfig.Timeout.Total)
-	timer := time.NewTimer(totalTimeout)
+	timer := time.NewTimer(time.Until(deadline))
+
+	allowlist, err := newAllowlist(userConfig.Allowlist)
+	if err != nil {
+		return nil, err
+	}
 
 	var s Scanner = &ImageScanner{
-		config: *userConfig,
-		timer:  timer,
+		config:    *userConfig,
+		timer:     timer,
+		cache:     cache,
+		allowlist: allowlist,
 	}
 	return s, nil
 }
 
-func scan(s Scanner, allImages []unversioned.Image) ([]unversioned.Image, []unversioned.Image, error) {
+// scanWorkers returns how many images to scan at once: the configured number,
+// within what the CPU and memory limits of the container allow.
+func scanWorkers(concurrency int) int {
+	workers := max(concurrency, 1)
+
+	if cpus, err := strconv.ParseInt(os.Getenv(utils.EnvScannerCPULimit), 10, 64); err == nil && cpus > 0 {
+		workers = min(workers, int(cpus))
+	}
+	if mem, err := strconv.ParseInt(os.Getenv(utils.EnvScannerMemoryLimit), 10, 64); err == nil && mem > 0 {
+		workers = min(workers, max(int(mem/scanMemory), 1))
+	}
+
+	return workers
+}
+
+// scan scans the images with the given number of workers. The first image is
+// scanned alone, so that the vulnerability database is downloaded once. The
+// images are returned in the order they were given. Once the total timeout
+// expires, the scans in progress are stopped and the images not scanned are
+// returned as failed.
+func scan(s Scanner, allImages []unversioned.Image, workers int) ([]unversioned.Image, []unversioned.Image, error) {
 	vulnerableImages := make([]unversioned.Image, 0, len(allImages))
 	failedImages := make([]unversioned.Image, 0, len(allImages))
-	// track total scan job time
 
-	for idx, img := range allImages {
+	ctx, cancel := context.WithCancelCause(context.Background())
+	defer cancel(nil)
+
+	go func() {
 		select {
 		case <-s.Timer().C:
-			failedImages = append(failedImages, allImages[idx:]...)
-			return vulnerableImages, failedImages, errors.New("image scan total timeout exceeded")
-		default:
-			// Logs scan failures
-			status, err := s.Scan(img)
-			if err != nil {
-				failedImages = append(failedImages, img)
-				log.Error(err, "scan failed")
-				continue
-			}
-
-			switch status {
-			case StatusNonCompliant:
-				log.Info("vulnerable image found", "img", img)
-				vulnerableImages = append(vulnerableImages, img)
-			case StatusFailed:
-				failedImages = append(failedImages, img)
-			}
+			cancel(errTotalTimeout)
+		case <-ctx.Done():
+		}
+	}()
+
+	statuses := make([]ScanStatus, len(allImages))
+	scanned := make([]bool, len(allImages))
+	scanImage := func(idx int) {
+		// Logs scan failures
+		status, err := s.Scan(ctx, allImages[idx])
+		if err != nil {
+			log.Error(err, "scan failed", "img", allImages[idx])
+			status = StatusFailed
+		}
+		statuses[idx] = status
+		scanned[idx] = true
+	}
+
+	slots := make(chan struct{}, max(workers, 1))
+	var wg sync.WaitGroup
+dispatch:
+	for idx := range allImages {
+		if idx == 0 {
+			scanImage(idx)
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case slots <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			scanImage(idx)
+		}()
+	}
+	wg.Wait()
+
+	for idx, img := range allImages {
+		if !scanned[idx] {
+			failedImages = append(failedImages, img)
+			continue
+		}
+
+		switch statuses[idx] {
+		case StatusNonCompliant:
+			log.Info("vulnerable image found", "img", img)
+			vulnerableImages = append(vulnerableImages, img)
+		case StatusFailed:
+			failedImages = append(failedImages, img)
 		}
 	}
 
+	if errors.Is(context.Cause(ctx), errTotalTimeout) {
+		return vulnerableImages, failedImages, errTotalTimeout
+	}
+
 	return vulnerableImages, failedImages, nil
 }
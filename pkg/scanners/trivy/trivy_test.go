@@ -1 +1,136 @@
 package main
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	"github.com/eraser-dev/eraser/pkg/utils"
+)
+
+// fakeScanner reports the images with a status, and fails the others.
+type fakeScanner struct {
+	statuses map[string]ScanStatus
+	delay    time.Duration
+	timer    *time.Timer
+
+	running    atomic.Int32
+	maxRunning atomic.Int32
+}
+
+func (s *fakeScanner) Scan(ctx context.Context, img unversioned.Image) (ScanStatus, error) {
+	running := s.running.Add(1)
+	defer s.running.Add(-1)
+	for {
+		maxRunning := s.maxRunning.Load()
+		if running <= maxRunning || s.maxRunning.CompareAndSwap(maxRunning, running) {
+			break
+		}
+	}
+
+	select {
+	case <-ctx.Done():
+		return StatusFailed, nil
+	case <-time.After(s.delay):
+	}
+
+	status, ok := s.statuses[img.ImageID]
+	if !ok {
+		return StatusFailed, errors.New("unable to scan")
+	}
+	return status, nil
+}
+
+func (s *fakeScanner) Timer() *time.Timer {
+	return s.timer
+}
+
+func images(ids ...string) []unversioned.Image {
+	imgs := make([]unversioned.Image, 0, len(ids))
+	for _, id := range ids {
+		imgs = append(imgs, unversioned.Image{ImageID: id})
+	}
+	return imgs
+}
+
+func imageIDs(imgs []unversioned.Image) []string {
+	ids := make([]string, 0, len(imgs))
+	for _, img := range imgs {
+		ids = append(ids, img.ImageID)
+	}
+	return ids
+}
+
+func TestScan(t *testing.T) {
+	s := &fakeScanner{
+		statuses: map[string]ScanStatus{
+			"a": StatusNonCompliant,
+			"b": StatusOK,
+			"c": StatusNonCompliant,
+			"e": StatusNonCompliant,
+			"f": StatusFailed,
+		},
+		delay: 10 * time.Millisecond,
+		timer: time.NewTimer(time.Minute),
+	}
+
+	vulnerable, failed, err := scan(s, images("a", "b", "c", "d", "e", "f"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := imageIDs(vulnerable); len(got) != 3 || got[0] != "a" || got[1] != "c" || got[2] != "e" {
+		t.Errorf("expected a, c and e to be vulnerable in order, got %v", got)
+	}
+	if got := imageIDs(failed); len(got) != 2 || got[0] != "d" || got[1] != "f" {
+		t.Errorf("expected d and f to have failed in order, got %v", got)
+	}
+	if got := s.maxRunning.Load(); got < 2 || got > 3 {
+		t.Errorf("expected up to 3 images to be scanned at once, got %d", got)
+	}
+}
+
+func TestScanTotalTimeout(t *testing.T) {
+	s := &fakeScanner{
+		statuses: map[string]ScanStatus{"a": StatusNonCompliant, "b": StatusNonCompliant, "c": StatusNonCompliant},
+		delay:    time.Minute,
+		timer:    time.NewTimer(50 * time.Millisecond),
+	}
+
+	vulnerable, failed, err := scan(s, images("a", "b", "c"), 2)
+	if !errors.Is(err, errTotalTimeout) {
+		t.Fatalf("expected the total timeout to be exceeded, got %v", err)
+	}
+	if len(vulnerable) != 0 || len(failed) != 3 {
+		t.Errorf("expected all images to have failed, got %v vulnerable and %v failed", vulnerable, failed)
+	}
+}
+
+func TestScanWorkers(t *testing.T) {
+	cases := map[string]struct {
+		concurrency int
+		cpu, memory string
+		want        int
+	}{
+		"no limits":     {concurrency: 8, want: 8},
+		"at least one":  {concurrency: 0, want: 1},
+		"cpu limit":     {concurrency: 8, cpu: "2", memory: "8589934592", want: 2},
+		"memory limit":  {concurrency: 8, cpu: "16", memory: "2147483648", want: 4},
+		"small memory":  {concurrency: 8, memory: "104857600", want: 1},
+		"within limits": {concurrency: 2, cpu: "4", memory: "8589934592", want: 2},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(utils.EnvScannerCPULimit, tc.cpu)
+			t.Setenv(utils.EnvScannerMemoryLimit, tc.memory)
+
+			if got := scanWorkers(tc.concurrency); got != tc.want {
+				t.Errorf("expected %d workers, got %d", tc.want, got)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -11,6 +12,7 @@ import (
 
 	trivyTypes "github.com/aquasecurity/trivy/pkg/types"
 	"github.com/eraser-dev/eraser/api/unversioned"
+	eraserConfig "github.com/eraser-dev/eraser/api/unversioned/config"
 	"github.com/eraser-dev/eraser/pkg/utils"
 )
 
@@ -28,6 +30,7 @@ const (
 	trivyImageArg           = "image"
 	trivyJSONFormatFlag     = "--format=json"
 	trivyCacheDirFlag       = "--cache-dir"
+	trivyCacheBackendFlag   = "--cache-backend"
 	trivyTimeoutFlag        = "--timeout"
 	trivyDBRepoFlag         = "--db-repository"
 	trivyIgnoreUnfixedFlag  = "--ignore-unfixed"
@@ -36,36 +39,22 @@ const (
 	trivySeveritiesFlag     = "--severity"
 	trivyRuntimeFlag        = "--image-src"
 	trivyIgnoreStatusFlag   = "--ignore-status"
+	trivyDownloadDBOnlyFlag = "--download-db-only"
+
+	trivyCacheBackendMemory = "memory"
 )
 
 type (
-	Config struct {
-		Runtime            unversioned.RuntimeSpec `json:"runtime,omitempty"`
-		CacheDir           string                  `json:"cacheDir,omitempty"`
-		DBRepo             string                  `json:"dbRepo,omitempty"`
-		DeleteFailedImages bool                    `json:"deleteFailedImages,omitempty"`
-		DeleteEOLImages    bool                    `json:"deleteEOLImages,omitempty"`
-		Vulnerabilities    VulnConfig              `json:"vulnerabilities,omitempty"`
-		Timeout            TimeoutConfig           `json:"timeout,omitempty"`
-	}
-
-	VulnConfig struct {
-		IgnoreUnfixed   bool     `json:"ignoreUnfixed,omitempty"`
-		Types           []string `json:"types,omitempty"`
-		SecurityChecks  []string `json:"securityChecks,omitempty"`
-		Severities      []string `json:"severities,omitempty"`
-		IgnoredStatuses []string `json:"ignoredStatuses,omitempty"`
-	}
-
-	TimeoutConfig struct {
-		Total    unversioned.Duration `json:"total,omitempty"`
-		PerImage unversioned.Duration `json:"perImage,omitempty"`
-	}
+	Config        = eraserConfig.TrivyConfig
+	PolicyConfig  = eraserConfig.TrivyPolicyConfig
+	Suppression   = eraserConfig.TrivySuppression
+	VulnConfig    = eraserConfig.TrivyVulnConfig
+	TimeoutConfig = eraserConfig.TrivyTimeoutConfig
 
 	ScanStatus int
 
 	Scanner interface {
-		Scan(unversioned.Image) (ScanStatus, error)
+		Scan(context.Context, unversioned.Image) (ScanStatus, error)
 		Timer() *time.Timer
 	}
 )
@@ -80,6 +69,8 @@ func DefaultConfig() *Config {
 		DBRepo:             "ghcr.io/aquasecurity/trivy-db",
 		DeleteFailedImages: true,
 		DeleteEOLImages:    true,
+		Concurrency:        1,
+		CacheResults:       true,
 		Vulnerabilities: VulnConfig{
 			IgnoreUnfixed: false,
 			Types: []string{
@@ -97,7 +88,7 @@ func DefaultConfig() *Config {
 	}
 }
 
-func (c *Config) cliArgs(ref string) []string {
+func cliArgs(c *Config, ref string) []string {
 	args := []string{}
 
 	// Global options
@@ -107,11 +98,17 @@ func (c *Config) cliArgs(ref string) []string {
 		args = append(args, trivyCacheDirFlag, c.CacheDir)
 	}
 
+	// the cache of the scanned layers on disk can only be opened by one trivy
+	// process at a time
+	if c.Concurrency > 1 {
+		args = append(args, trivyCacheBackendFlag, trivyCacheBackendMemory)
+	}
+
 	if c.Timeout.PerImage != 0 {
 		args = append(args, trivyTimeoutFlag, time.Duration(c.Timeout.PerImage).String())
 	}
 
-	runtimeVar, err := c.getRuntimeVar()
+	runtimeVar, err := getRuntimeVar(c)
 	if err != nil {
 		log.Error(err, "invalid runtime provided")
 	}
@@ -151,7 +148,24 @@ func (c *Config) cliArgs(ref string) []string {
 	return args
 }
 
-func (c *Config) getRuntimeVar() (string, error) {
+// dbArgs returns the arguments to only update the vulnerability database.
+func dbArgs(c *Config) []string {
+	args := []string{}
+
+	if c.CacheDir != "" {
+		args = append(args, trivyCacheDirFlag, c.CacheDir)
+	}
+
+	args = append(args, trivyImageArg, trivyDownloadDBOnlyFlag)
+
+	if c.DBRepo != "" {
+		args = append(args, trivyDBRepoFlag, c.DBRepo)
+	}
+
+	return args
+}
+
+func getRuntimeVar(c *Config) (string, error) {
 	var imgsrc string
 	runtimeName := c.Runtime.Name
 	switch runtimeName {
@@ -170,13 +184,41 @@ func (c *Config) getRuntimeVar() (string, error) {
 type ImageScanner struct {
 	config Config
 	timer  *time.Timer
+	// verdicts of previous scans, nil unless they are cached
+	cache *scanCache
+	// vulnerabilities whose risk is accepted
+	allowlist allowlist
 }
 
-func (s *ImageScanner) Scan(img unversioned.Image) (ScanStatus, error) {
+// Scan scans an image, unless its verdict is cached.
+func (s *ImageScanner) Scan(ctx context.Context, img unversioned.Image) (ScanStatus, error) {
+	if s.cache == nil {
+		status, _, err := s.scanRefs(ctx, img)
+		return status, err
+	}
+
+	if status, ok := s.cache.get(img.ImageID, time.Now()); ok {
+		log.Info("using the verdict of a previous scan", "imageID", img.ImageID)
+		return status, nil
+	}
+
+	status, validUntil, err := s.scanRefs(ctx, img)
+	if err == nil {
+		s.cache.put(img.ImageID, status, validUntil, time.Now())
+	}
+
+	return status, err
+}
+
+// scanRefs scans an image under each of its references, until a scan
+// succeeds. A compliant image may only be compliant until a suppression of
+// the allowlist expires, which is returned.
+func (s *ImageScanner) scanRefs(ctx context.Context, img unversioned.Image) (ScanStatus, *time.Time, error) {
 	refs := make([]string, 0, len(img.Names)+len(img.Digests))
 	refs = append(refs, img.Digests...)
 	refs = append(refs, img.Names...)
 	scanSucceeded := false
+	var validUntil *time.Time
 
 	log.Info("scanning image with id", "imageID", img.ImageID, "refs", refs)
 	for i := 0; i < len(refs) && !scanSucceeded; i++ {
@@ -185,9 +227,9 @@ func (s *ImageScanner) Scan(img unversioned.Image) (ScanStatus, error) {
 		stdout := new(bytes.Buffer)
 		stderr := new(bytes.Buffer)
 
-		cliArgs := s.config.cliArgs(refs[i])
+		cliArgs := cliArgs(&s.config, refs[i])
 		//nolint:gosec // G204: Trivy subprocess execution is intended functionality
-		cmd := exec.Command(trivyCommandName, cliArgs...)
+		cmd := exec.CommandContext(ctx, trivyCommandName, cliArgs...)
 		cmd.Stdout = stdout
 		cmd.Stderr = stderr
 		cmd.Env = append(cmd.Env, os.Environ()...)
@@ -208,15 +250,15 @@ func (s *ImageScanner) Scan(img unversioned.Image) (ScanStatus, error) {
 		if s.config.DeleteEOLImages {
 			if report.Metadata.OS != nil && report.Metadata.OS.Eosl {
 				log.Info("image is end of life", "imageID", img.ImageID, "reference", refs[i])
-				return StatusNonCompliant, nil
+				return StatusNonCompliant, nil, nil
 			}
 		}
 
-		for j := range report.Results {
-			if len(report.Results[j].Vulnerabilities) > 0 {
-				return StatusNonCompliant, nil
-			}
+		vulnerable, until := s.vulnerable(img, &report, time.Now())
+		if vulnerable {
+			return StatusNonCompliant, nil, nil
 		}
+		validUntil = until
 
 		// causes a break from the loop
 		scanSucceeded = true
@@ -227,7 +269,49 @@ func (s *ImageScanner) Scan(img unversioned.Image) (ScanStatus, error) {
 		status = StatusFailed
 	}
 
-	return status, nil
+	return status, validUntil, nil
+}
+
+// vulnerable reports whether the report has vulnerabilities that make the
+// image non-compliant under the policy, once the ones the allowlist
+// suppresses are left out. Each suppression is logged. Otherwise, the time
+// from which the verdict may change is returned, if any: when a suppression
+// expires or a vulnerability becomes old enough to count.
+func (s *ImageScanner) vulnerable(img unversioned.Image, report *trivyTypes.Report, now time.Time) (bool, *time.Time) {
+	var validUntil *time.Time
+	until := func(t *time.Time) {
+		if t != nil && (validUntil == nil || t.Before(*validUntil)) {
+			validUntil = t
+		}
+	}
+
+	policy := &s.config.Policy
+	counts := map[string]int{}
+	for j := range report.Results {
+		for k := range report.Results[j].Vulnerabilities {
+			vuln := &report.Results[j].Vulnerabilities[k]
+
+			if rule := s.allowlist.suppresses(img, vuln, now); rule != nil {
+				log.Info("vulnerability suppressed", "imageID", img.ImageID, "vulnerabilityID", vuln.VulnerabilityID, "package", vuln.PkgName, "reason", rule.Reason, "expires", rule.Expires)
+				until(rule.expires)
+				continue
+			}
+
+			counted, from := policyCounts(policy, vuln, now)
+			if !counted {
+				until(from)
+				continue
+			}
+
+			counts[vuln.Severity]++
+			if policyExceeded(policy, vuln.Severity, counts[vuln.Severity]) {
+				log.V(1).Info("vulnerability makes the image non-compliant", "imageID", img.ImageID, "vulnerabilityID", vuln.VulnerabilityID, "severity", vuln.Severity, "count", counts[vuln.Severity])
+				return true, nil
+			}
+		}
+	}
+
+	return false, validUntil
 }
 
 func setRuntimeSocketEnvVars(cmd *exec.Cmd, runtime unversioned.RuntimeSpec) []string {
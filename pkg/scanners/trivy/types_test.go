@@ -53,6 +53,11 @@ func TestCLIArgs(t *testing.T) {
 			config:   Config{CacheDir: "/var/lib/trivy"},
 			expected: []string{"--format=json", "--cache-dir", "/var/lib/trivy", "image", "--image-src", ImgSrcContainerd, ref},
 		},
+		{
+			desc:     "concurrent scans keep their cache in memory",
+			config:   Config{CacheDir: "/var/lib/trivy", Concurrency: 4},
+			expected: []string{"--format=json", "--cache-dir", "/var/lib/trivy", "--cache-backend", "memory", "image", "--image-src", ImgSrcContainerd, ref},
+		},
 		{
 			desc:     "with custom db repo",
 			config:   Config{DBRepo: "example.test/db/repo"},
@@ -148,7 +153,7 @@ func TestCLIArgs(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.desc, func(t *testing.T) {
-			actual := tt.config.cliArgs(ref)
+			actual := cliArgs(&tt.config, ref)
 			if len(actual) != len(tt.expected) {
 				t.Logf("expected resulting length to be %d, was actually %d", len(actual), len(tt.expected))
 				t.Fail()
@@ -167,3 +172,12 @@ func TestCLIArgs(t *testing.T) {
 		})
 	}
 }
+
+func TestDBArgs(t *testing.T) {
+	cfg := Config{CacheDir: "/var/lib/trivy", DBRepo: "example.test/db/repo", Vulnerabilities: VulnConfig{IgnoreUnfixed: true}}
+
+	expected := "--cache-dir /var/lib/trivy image --download-db-only --db-repository example.test/db/repo"
+	if actual := strings.Join(dbArgs(&cfg), " "); actual != expected {
+		t.Errorf("expected `%s`, got `%s`", expected, actual)
+	}
+}
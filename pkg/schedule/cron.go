@@ -0,0 +1,23 @@
+package schedule
+
+import (
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+)
+
+// ParseCron parses a cron expression in the standard five field format, such
+// as "0 2 * * sun". The @daily style macros and a CRON_TZ= prefix, which
+// overrides the time zone of the schedule, are supported as well.
+//
+// The returned schedule's Next returns the first matching time strictly after
+// the given time, in its location, or the zero time if there is none within
+// five years, e.g. for "0 0 30 2 *".
+func ParseCron(expr string) (cron.Schedule, error) {
+	c, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", expr, err)
+	}
+
+	return c, nil
+}
@@ -0,0 +1,143 @@
+// Package schedule computes when eraser is allowed to start ImageJobs.
+package schedule
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+)
+
+// maxWindowSteps bounds the search for a time outside of the blackout
+// windows, in case the windows cover all of the time.
+const maxWindowSteps = 1000
+
+type window struct {
+	start    cron.Schedule
+	duration time.Duration
+}
+
+// Schedule decides when collector ImageJobs run, either at a fixed interval
+// or according to a cron expression, and when no ImageJob may start at all.
+type Schedule struct {
+	cron             cron.Schedule
+	interval         time.Duration
+	beginImmediately bool
+	location         *time.Location
+	blackouts        []window
+}
+
+// New creates a Schedule from the scheduling configuration.
+func New(cfg *unversioned.ScheduleConfig) (*Schedule, error) {
+	s := &Schedule{
+		interval:         time.Duration(cfg.RepeatInterval),
+		beginImmediately: cfg.BeginImmediately,
+		location:         time.UTC,
+	}
+
+	if cfg.TimeZone != "" {
+		loc, err := time.LoadLocation(cfg.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", cfg.TimeZone, err)
+		}
+		s.location = loc
+	}
+
+	if cfg.Cron != "" {
+		c, err := ParseCron(cfg.Cron)
+		if err != nil {
+			return nil, err
+		}
+		if c.Next(time.Now().In(s.location)).IsZero() {
+			return nil, fmt.Errorf("cron expression %q never matches", cfg.Cron)
+		}
+		s.cron = c
+	} else if s.interval <= 0 {
+		return nil, fmt.Errorf("invalid repeat interval %s: must be greater than zero", s.interval)
+	}
+
+	for _, bw := range cfg.BlackoutWindows {
+		c, err := ParseCron(bw.Start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blackout window: %w", err)
+		}
+
+		d := time.Duration(bw.Duration)
+		if d <= 0 {
+			return nil, fmt.Errorf("invalid blackout window %q: duration must be greater than zero", bw.Start)
+		}
+
+		s.blackouts = append(s.blackouts, window{start: c, duration: d})
+	}
+
+	return s, nil
+}
+
+// First returns the time of the first run after the manager starts at now.
+func (s *Schedule) First(now time.Time) time.Time {
+	if s.beginImmediately {
+		return s.Allowed(now)
+	}
+
+	return s.Next(now, now)
+}
+
+// Next returns the time of the run following one that completed at
+// completed. With a repeat interval, runs are spaced from the completion of
+// the previous run. With a cron expression, the next run is the first
+// scheduled time after now, so runs missed while the previous ImageJob was
+// still around are skipped.
+func (s *Schedule) Next(completed, now time.Time) time.Time {
+	if s.cron == nil {
+		return s.Allowed(completed.Add(s.interval))
+	}
+
+	next := s.cron.Next(now.In(s.location))
+	for i := 0; i < maxWindowSteps && !next.IsZero(); i++ {
+		if _, blocked := s.blackoutEnd(next); !blocked {
+			return next
+		}
+		next = s.cron.Next(next)
+	}
+
+	return time.Time{}
+}
+
+// Allowed returns the first time at or after t that is not within a blackout
+// window.
+func (s *Schedule) Allowed(t time.Time) time.Time {
+	for i := 0; i < maxWindowSteps; i++ {
+		end, blocked := s.blackoutEnd(t)
+		if !blocked {
+			return t
+		}
+		t = end
+	}
+
+	return time.Time{}
+}
+
+// InBlackout reports whether t is within a blackout window.
+func (s *Schedule) InBlackout(t time.Time) bool {
+	_, blocked := s.blackoutEnd(t)
+	return blocked
+}
+
+// blackoutEnd returns the end of the blackout windows containing t, if any.
+func (s *Schedule) blackoutEnd(t time.Time) (time.Time, bool) {
+	t = t.In(s.location)
+
+	var end time.Time
+	for _, w := range s.blackouts {
+		// a window contains t if it started in (t - duration, t]
+		for start := w.start.Next(t.Add(-w.duration)); !start.IsZero() && !start.After(t); start = w.start.Next(start) {
+			if e := start.Add(w.duration); e.After(end) {
+				end = e
+			}
+		}
+	}
+
+	return end, !end.IsZero()
+}
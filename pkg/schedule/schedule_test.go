@@ -0,0 +1,288 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+)
+
+func mustTime(t *testing.T, loc *time.Location, value string) time.Time {
+	t.Helper()
+
+	ts, err := time.ParseInLocation("2006-01-02 15:04", value, loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ts
+}
+
+func TestParseCron(t *testing.T) {
+	cases := map[string]struct {
+		expr    string
+		wantErr bool
+	}{
+		"every minute":        {expr: "* * * * *"},
+		"names":               {expr: "0 2 * jan-mar sun"},
+		"lists and steps":     {expr: "0,30 */6 1-15/2 * 1-5"},
+		"macro":               {expr: "@weekly"},
+		"time zone prefix":    {expr: "CRON_TZ=Europe/Berlin 0 2 * * *"},
+		"sunday as seven":     {expr: "0 0 * * 7", wantErr: true},
+		"too few fields":      {expr: "0 2 * *", wantErr: true},
+		"minute out of range": {expr: "60 * * * *", wantErr: true},
+		"reversed range":      {expr: "* 5-1 * * *", wantErr: true},
+		"zero step":           {expr: "*/0 * * * *", wantErr: true},
+		"unknown name":        {expr: "* * * * funday", wantErr: true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseCron(tc.expr)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ParseCron(%q) error = %v, wantErr %v", tc.expr, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		expr string
+		loc  *time.Location
+		from string
+		want string
+	}{
+		"sunday at two": {
+			expr: "0 2 * * sun", loc: time.UTC,
+			from: "2024-05-15 10:00", want: "2024-05-19 02:00",
+		},
+		"strictly after": {
+			expr: "0 2 * * *", loc: time.UTC,
+			from: "2024-05-15 02:00", want: "2024-05-16 02:00",
+		},
+		"day of month or weekday": {
+			expr: "0 0 13 * fri", loc: time.UTC,
+			from: "2024-05-01 00:00", want: "2024-05-03 00:00",
+		},
+		"day of month or weekday, day of month first": {
+			expr: "0 0 13 * fri", loc: time.UTC,
+			from: "2024-05-10 00:00", want: "2024-05-13 00:00",
+		},
+		"day of month range or weekday": {
+			expr: "0 0 1-7 * mon", loc: time.UTC,
+			from: "2024-05-08 00:00", want: "2024-05-13 00:00",
+		},
+		"weekday only": {
+			expr: "0 0 * * fri", loc: time.UTC,
+			from: "2024-05-10 00:00", want: "2024-05-17 00:00",
+		},
+		"day of month only": {
+			expr: "0 0 13 * *", loc: time.UTC,
+			from: "2024-05-13 00:00", want: "2024-06-13 00:00",
+		},
+		"next year": {
+			expr: "30 4 1 1 *", loc: time.UTC,
+			from: "2024-05-01 00:00", want: "2025-01-01 04:30",
+		},
+		"time zone": {
+			expr: "0 2 * * *", loc: ny,
+			from: "2024-05-15 03:00", want: "2024-05-16 02:00",
+		},
+		"skipped by daylight saving time": {
+			expr: "30 2 * * *", loc: ny,
+			from: "2024-03-09 12:00", want: "2024-03-11 02:30",
+		},
+		"time zone prefix": {
+			expr: "CRON_TZ=America/New_York 0 2 * * *", loc: time.UTC,
+			from: "2024-05-15 00:00", want: "2024-05-15 06:00",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := ParseCron(tc.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := c.Next(mustTime(t, tc.loc, tc.from))
+			if want := mustTime(t, tc.loc, tc.want); !got.Equal(want) {
+				t.Errorf("Next() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestCronNextDaylightSaving(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the local times around the transitions are ambiguous or do not exist,
+	// so the times are given in UTC.
+	cases := map[string]struct {
+		expr string
+		loc  *time.Location
+		from string
+		want string
+	}{
+		"after the skipped hour": {
+			expr: "0 3 * * *", loc: ny,
+			from: "2024-03-09 17:00", want: "2024-03-10 07:00",
+		},
+		"in the skipped hour": {
+			expr: "30 2 * * *", loc: ny,
+			from: "2024-03-09 17:00", want: "2024-03-11 06:30",
+		},
+		"in the skipped hour on a weekday": {
+			expr: "0 2 * * sun", loc: berlin,
+			from: "2024-03-30 12:00", want: "2024-04-07 00:00",
+		},
+		"first of the repeated hour": {
+			expr: "30 1 * * *", loc: ny,
+			from: "2024-11-02 16:00", want: "2024-11-03 05:30",
+		},
+		"second of the repeated hour": {
+			expr: "30 1 * * *", loc: ny,
+			from: "2024-11-03 05:30", want: "2024-11-03 06:30",
+		},
+		"after the repeated hour": {
+			expr: "30 1 * * *", loc: ny,
+			from: "2024-11-03 06:30", want: "2024-11-04 06:30",
+		},
+		"hourly through the repeated hour": {
+			expr: "0 * * * *", loc: ny,
+			from: "2024-11-03 06:00", want: "2024-11-03 07:00",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c, err := ParseCron(tc.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := c.Next(mustTime(t, time.UTC, tc.from).In(tc.loc))
+			if want := mustTime(t, time.UTC, tc.want); !got.Equal(want) {
+				t.Errorf("Next() = %s, want %s", got, want.In(tc.loc))
+			}
+		})
+	}
+}
+
+func TestScheduleBlackout(t *testing.T) {
+	// business hours on weekdays
+	s, err := New(&unversioned.ScheduleConfig{
+		RepeatInterval: unversioned.Duration(time.Hour),
+		BlackoutWindows: []unversioned.BlackoutWindow{
+			{Start: "0 9 * * mon-fri", Duration: unversioned.Duration(8 * time.Hour)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		at      string
+		blocked bool
+		allowed string
+	}{
+		"before window":   {at: "2024-05-15 08:59", blocked: false, allowed: "2024-05-15 08:59"},
+		"start of window": {at: "2024-05-15 09:00", blocked: true, allowed: "2024-05-15 17:00"},
+		"within window":   {at: "2024-05-15 12:30", blocked: true, allowed: "2024-05-15 17:00"},
+		"end of window":   {at: "2024-05-15 17:00", blocked: false, allowed: "2024-05-15 17:00"},
+		"weekend":         {at: "2024-05-18 12:00", blocked: false, allowed: "2024-05-18 12:00"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			at := mustTime(t, time.UTC, tc.at)
+			if got := s.InBlackout(at); got != tc.blocked {
+				t.Errorf("InBlackout() = %v, want %v", got, tc.blocked)
+			}
+			if got, want := s.Allowed(at), mustTime(t, time.UTC, tc.allowed); !got.Equal(want) {
+				t.Errorf("Allowed() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	window := []unversioned.BlackoutWindow{
+		{Start: "0 9 * * *", Duration: unversioned.Duration(8 * time.Hour)},
+	}
+
+	cases := map[string]struct {
+		cfg       unversioned.ScheduleConfig
+		completed string
+		now       string
+		want      string
+	}{
+		"interval": {
+			cfg:       unversioned.ScheduleConfig{RepeatInterval: unversioned.Duration(24 * time.Hour)},
+			completed: "2024-05-15 03:00", now: "2024-05-15 05:00", want: "2024-05-16 03:00",
+		},
+		"interval ending in blackout": {
+			cfg:       unversioned.ScheduleConfig{RepeatInterval: unversioned.Duration(6 * time.Hour), BlackoutWindows: window},
+			completed: "2024-05-15 06:00", now: "2024-05-15 06:00", want: "2024-05-15 17:00",
+		},
+		"cron": {
+			cfg:       unversioned.ScheduleConfig{Cron: "0 2 * * *"},
+			completed: "2024-05-14 02:10", now: "2024-05-15 03:00", want: "2024-05-16 02:00",
+		},
+		"cron in time zone across daylight saving time": {
+			cfg:       unversioned.ScheduleConfig{Cron: "0 2 * * *", TimeZone: "Europe/Berlin"},
+			completed: "2024-03-30 01:10", now: "2024-03-30 03:00", want: "2024-04-01 00:00",
+		},
+		"cron skips blackout": {
+			cfg:       unversioned.ScheduleConfig{Cron: "0 */4 * * *", BlackoutWindows: window},
+			completed: "2024-05-15 08:10", now: "2024-05-15 08:10", want: "2024-05-15 20:00",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := New(&tc.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := s.Next(mustTime(t, time.UTC, tc.completed), mustTime(t, time.UTC, tc.now))
+			if want := mustTime(t, time.UTC, tc.want); !got.Equal(want) {
+				t.Errorf("Next() = %s, want %s", got, want)
+			}
+		})
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	cases := map[string]unversioned.ScheduleConfig{
+		"no interval":         {},
+		"bad cron":            {Cron: "every sunday"},
+		"bad time zone":       {Cron: "@daily", TimeZone: "Mars/Olympus_Mons"},
+		"never matches":       {Cron: "0 0 30 2 *"},
+		"bad window":          {Cron: "@daily", BlackoutWindows: []unversioned.BlackoutWindow{{Start: "9am", Duration: unversioned.Duration(time.Hour)}}},
+		"window without time": {Cron: "@daily", BlackoutWindows: []unversioned.BlackoutWindow{{Start: "0 9 * * *"}}},
+	}
+
+	for name, cfg := range cases {
+		cfg := cfg
+		t.Run(name, func(t *testing.T) {
+			if _, err := New(&cfg); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -9,3 +9,9 @@ func GetNamespace() string {
 	}
 	return ns
 }
+
+// GetPodName returns the name of the pod this process runs in, or an empty
+// string if it is not known.
+func GetPodName() string {
+	return os.Getenv("POD_NAME")
+}
@@ -9,6 +9,8 @@ import (
 	"net"
 	"net/url"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,6 +20,7 @@ import (
 	v1 "k8s.io/cri-api/pkg/apis/runtime/v1"
 
 	"github.com/eraser-dev/eraser/api/unversioned"
+	"github.com/eraser-dev/eraser/pkg/imagepattern"
 )
 
 const (
@@ -29,10 +32,32 @@ const (
 	EraseCompleteCollectPath = "/run/eraser.sh/shared-data/eraseCompleteCollect"
 	EraseCompleteMessage     = "complete"
 	EraseCompleteScanPath    = "/run/eraser.sh/shared-data/eraseCompleteScan"
+	// ExclusionCountsPath is where the collector leaves the number of images
+	// each exclusion pattern protected, for the remover to report.
+	ExclusionCountsPath = "/run/eraser.sh/shared-data/exclusionCounts"
+	// ImageUsageDir is the host directory where the collector keeps track of
+	// when images were last used, and ImageUsagePath the file it uses.
+	ImageUsageDir  = "/var/lib/eraser"
+	ImageUsagePath = ImageUsageDir + "/image-usage.json"
+	// ScanCachePath is where the scanner keeps the verdicts of the images it
+	// scanned, in the same host directory.
+	ScanCachePath = ImageUsageDir + "/scan-cache.json"
+	// ImageFsMountPath is where a directory of the image filesystem of the
+	// node is mounted, to measure its usage.
+	ImageFsMountPath = "/run/eraser.sh/imagefs"
 
 	CRIPath = "/run/cri/cri.sock"
 
+	// TerminationMessagePath is where the remover reports its results to the
+	// controller. The kubelet ignores anything beyond maxTerminationMessageSize.
+	TerminationMessagePath    = "/dev/termination-log"
+	maxTerminationMessageSize = 4096
+
 	EnvEraserRuntimeName = "ERASER_RUNTIME_NAME"
+	// EnvScannerCPULimit and EnvScannerMemoryLimit hold the CPU, in cores, and
+	// the memory, in bytes, the scanner container may use.
+	EnvScannerCPULimit    = "ERASER_SCANNER_CPU_LIMIT"
+	EnvScannerMemoryLimit = "ERASER_SCANNER_MEMORY_LIMIT"
 )
 
 type ExclusionList struct {
@@ -169,80 +194,23 @@ func GetNonRunningImages(runningImages map[string]string, allImages []unversione
 	return nonRunningImages
 }
 
-func IsExcluded(excluded map[string]struct{}, img string, idToImageMap map[string]unversioned.Image) bool {
-	if len(excluded) == 0 {
-		return false
-	}
-
-	// check if img excluded by digest
-	if _, contains := excluded[img]; contains {
-		return true
-	}
-
-	// check if img excluded by name
-	for _, imgName := range idToImageMap[img].Names {
-		if _, contains := excluded[imgName]; contains {
-			return true
-		}
-	}
+func IsExcluded(excluded *imagepattern.Set, img string, idToImageMap map[string]unversioned.Image) bool {
+	return len(ExcludedBy(excluded, img, idToImageMap)) > 0
+}
 
-	for _, digest := range idToImageMap[img].Digests {
-		if _, contains := excluded[digest]; contains {
-			return true
-		}
+// ExcludedBy returns the sorted exclusion patterns that match the image ID,
+// or one of the names or digests of the image, so that the protected images
+// can be counted for each pattern.
+func ExcludedBy(excluded *imagepattern.Set, img string, idToImageMap map[string]unversioned.Image) []string {
+	if excluded.Len() == 0 {
+		return nil
 	}
 
-	// look for excluded repository values and names without tag
-	for key := range excluded {
-		// if excluded key ends in /*, check image with pattern match
-		if strings.HasSuffix(key, "/*") {
-			// store repository name
-			repo := strings.Split(key, "*")
+	names := []string{img}
+	names = append(names, idToImageMap[img].Names...)
+	names = append(names, idToImageMap[img].Digests...)
 
-			// check if img is part of repo
-			if match := strings.HasPrefix(img, repo[0]); match {
-				return true
-			}
-
-			// retrieve and check by name in the case img is digest
-			for _, imgName := range idToImageMap[img].Names {
-				if match := strings.HasPrefix(imgName, repo[0]); match {
-					return true
-				}
-			}
-
-			for _, digest := range idToImageMap[img].Digests {
-				if match := strings.HasPrefix(digest, repo[0]); match {
-					return true
-				}
-			}
-		}
-
-		// if excluded key ends in :*, check image with pattern patch
-		if strings.HasSuffix(key, ":*") {
-			// store image name
-			imagePath := strings.Split(key, ":")
-
-			if match := strings.HasPrefix(img, imagePath[0]); match {
-				return true
-			}
-
-			// retrieve and check by name in the case img is digest
-			for _, imgName := range idToImageMap[img].Names {
-				if match := strings.HasPrefix(imgName, imagePath[0]); match {
-					return true
-				}
-			}
-
-			for _, digest := range idToImageMap[img].Digests {
-				if match := strings.HasPrefix(digest, imagePath[0]); match {
-					return true
-				}
-			}
-		}
-	}
-
-	return false
+	return excluded.Matching(names...)
 }
 
 func ParseImageList(path string) ([]string, error) {
@@ -260,8 +228,8 @@ func ParseImageList(path string) ([]string, error) {
 	return imagelist, nil
 }
 
-func ParseExcluded() (map[string]struct{}, error) {
-	excludedMap := make(map[string]struct{})
+// ParseExcluded reads the exclusion patterns from the exclude-* directories.
+func ParseExcluded() (*imagepattern.Set, error) {
 	var excludedList []string
 
 	files, err := os.ReadDir("./")
@@ -279,11 +247,7 @@ func ParseExcluded() (map[string]struct{}, error) {
 		}
 	}
 
-	for _, img := range excludedList {
-		excludedMap[img] = struct{}{}
-	}
-
-	return excludedMap, nil
+	return imagepattern.NewSet(excludedList)
 }
 
 func BoolPtr(b bool) *bool {
@@ -291,38 +255,63 @@ func BoolPtr(b bool) *bool {
 }
 
 func readConfigMap(path string) ([]string, error) {
-	var fileName string
-
 	files, err := os.ReadDir(path)
 	if err != nil {
 		return nil, err
 	}
 
+	var images []string
 	for _, f := range files {
-		if strings.HasSuffix(f.Name(), ".json") {
-			fileName = f.Name()
-			break
+		if !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+
+		//nolint:gosec // G304: Reading excluded images file is intended functionality
+		data, err := os.ReadFile(filepath.Join(path, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		var result ExclusionList
+		if err := json.Unmarshal(data, &result); err != nil {
+			return nil, fmt.Errorf("parse %s: %w", f.Name(), err)
 		}
+
+		images = append(images, result.Excluded...)
 	}
 
-	var images []string
-	//nolint:gosec // G304: Reading excluded images file is intended functionality
-	data, err := os.ReadFile(path + "/" + fileName)
+	return images, nil
+}
+
+// WriteExclusionCounts stores the number of images each exclusion pattern
+// protected.
+func WriteExclusionCounts(path string, counts map[string]int64) error {
+	data, err := json.Marshal(counts)
+	if err != nil {
+		return err
+	}
 
+	return os.WriteFile(path, data, PipeMode)
+}
+
+// ReadExclusionCounts returns the counts stored by WriteExclusionCounts, or
+// nil if there are none.
+func ReadExclusionCounts(path string) (map[string]int64, error) {
+	//nolint:gosec // G304: Reading exclusion counts file is intended functionality
+	data, err := os.ReadFile(path)
 	if os.IsNotExist(err) {
-		return nil, err
-	} else if err != nil {
+		return nil, nil
+	}
+	if err != nil {
 		return nil, err
 	}
 
-	var result ExclusionList
-	if err := json.Unmarshal(data, &result); err != nil {
+	counts := map[string]int64{}
+	if err := json.Unmarshal(data, &counts); err != nil {
 		return nil, err
 	}
 
-	images = append(images, result.Excluded...)
-
-	return images, nil
+	return counts, nil
 }
 
 func ReadCollectScanPipe(ctx context.Context) ([]unversioned.Image, error) {
@@ -410,3 +399,122 @@ func ProcessRepoDigests(repoDigests []string) ([]string, []error) {
 
 	return digests, errs
 }
+
+// EncodeNodeResult encodes the result as JSON that fits in a termination
+// message. Per-image results that do not fit are left out, and the result is
+// marked as truncated, then the exclusion patterns that protected the fewest
+// images. The counts are always kept.
+func EncodeNodeResult(result unversioned.NodeResult) ([]byte, error) {
+	data, ok, err := encodeFitting(&result)
+	if err != nil || ok {
+		return data, err
+	}
+
+	images := result.Images
+	result.ImagesTruncated = true
+	n := largestFitting(len(images), func(n int) bool {
+		result.Images = images[:n]
+		_, ok, _ := encodeFitting(&result)
+		return ok
+	})
+	result.Images = images[:n]
+
+	if n == 0 {
+		exclusions := result.Exclusions
+		patterns := make([]string, 0, len(exclusions))
+		for pattern := range exclusions {
+			patterns = append(patterns, pattern)
+		}
+		sort.Slice(patterns, func(i, j int) bool {
+			if exclusions[patterns[i]] != exclusions[patterns[j]] {
+				return exclusions[patterns[i]] > exclusions[patterns[j]]
+			}
+			return patterns[i] < patterns[j]
+		})
+
+		keep := func(n int) map[string]int64 {
+			kept := make(map[string]int64, n)
+			for _, pattern := range patterns[:n] {
+				kept[pattern] = exclusions[pattern]
+			}
+			return kept
+		}
+		kept := largestFitting(len(patterns), func(n int) bool {
+			result.Exclusions = keep(n)
+			_, ok, _ := encodeFitting(&result)
+			return ok
+		})
+		result.Exclusions = keep(kept)
+	}
+
+	if data, ok, err = encodeFitting(&result); err != nil || ok {
+		return data, err
+	}
+
+	// the counts alone
+	result.Exclusions = nil
+	result.ImageFs = nil
+	if data, ok, err = encodeFitting(&result); err != nil || ok {
+		return data, err
+	}
+
+	return nil, fmt.Errorf("node result does not fit in %d bytes", maxTerminationMessageSize)
+}
+
+// encodeFitting encodes the result as JSON, and reports whether it fits in a
+// termination message.
+func encodeFitting(result *unversioned.NodeResult) ([]byte, bool, error) {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return data, len(data) <= maxTerminationMessageSize, nil
+}
+
+// largestFitting returns the largest n, up to limit, for which fits returns
+// true, assuming that it does for all the smaller ones. It returns 0 if none
+// fits.
+func largestFitting(limit int, fits func(n int) bool) int {
+	lo, hi := 0, limit
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+		if fits(mid) {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	return lo
+}
+
+// ScanResult is what a scanner reports to the manager through its termination
+// message.
+type ScanResult struct {
+	// Number of images found vulnerable
+	Vulnerable int64 `json:"vulnerable"`
+	// Number of images that could not be scanned
+	Failed int64 `json:"failed"`
+}
+
+// WriteScanResult reports the result of a scan through the container's
+// termination message.
+func WriteScanResult(result ScanResult) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(TerminationMessagePath, data, 0o600)
+}
+
+// WriteNodeResult reports the result through the container's termination message.
+func WriteNodeResult(result unversioned.NodeResult) error {
+	data, err := EncodeNodeResult(result)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(TerminationMessagePath, data, 0o600)
+}
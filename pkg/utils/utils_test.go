@@ -1,10 +1,18 @@
 package utils
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/eraser-dev/eraser/api/unversioned"
+	"github.com/eraser-dev/eraser/pkg/imagepattern"
 )
 
 func TestParseEndpointWithFallBackProtocol(t *testing.T) {
@@ -163,3 +171,203 @@ func TestGetAddressAndDialer(t *testing.T) {
 		}
 	}
 }
+
+func TestEncodeNodeResult(t *testing.T) {
+	testCases := map[string]struct {
+		images  int
+		keepAll bool
+	}{
+		"no images":       {images: 0, keepAll: true},
+		"few images":      {images: 10, keepAll: true},
+		"too many images": {images: 1000, keepAll: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := unversioned.NodeResult{Removed: int64(tc.images)}
+			for i := 0; i < tc.images; i++ {
+				result.Images = append(result.Images, unversioned.ImageResult{
+					Image:  fmt.Sprintf("docker.io/library/image%d:%s", i, strings.Repeat("a", 20)),
+					Result: unversioned.ImageRemoved,
+				})
+			}
+
+			data, err := EncodeNodeResult(result)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data) > maxTerminationMessageSize {
+				t.Fatalf("encoded result is %d bytes, limit is %d", len(data), maxTerminationMessageSize)
+			}
+
+			var decoded unversioned.NodeResult
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatal(err)
+			}
+			if decoded.Removed != int64(tc.images) {
+				t.Errorf("expected removed count %d, got %d", tc.images, decoded.Removed)
+			}
+			if tc.keepAll && len(decoded.Images) != tc.images {
+				t.Errorf("expected %d images, got %d", tc.images, len(decoded.Images))
+			}
+			if !tc.keepAll && (len(decoded.Images) == 0 || len(decoded.Images) >= tc.images) {
+				t.Errorf("expected the image list to be cut short, got %d images", len(decoded.Images))
+			}
+			if decoded.ImagesTruncated == tc.keepAll {
+				t.Errorf("expected imagesTruncated to be %v", !tc.keepAll)
+			}
+		})
+	}
+}
+
+func TestEncodeNodeResultExclusions(t *testing.T) {
+	testCases := map[string]struct {
+		patterns      int
+		patternLength int
+		wantSome      bool
+	}{
+		"hundreds of patterns": {patterns: 300, patternLength: 40, wantSome: true},
+		"pattern too long":     {patterns: 1, patternLength: 5000, wantSome: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			result := unversioned.NodeResult{
+				Name:       "node-a",
+				Removed:    100,
+				Excluded:   int64(tc.patterns),
+				Errors:     3,
+				Exclusions: map[string]int64{},
+				ImageFs:    &unversioned.ImageFsUsage{CapacityBytes: 100},
+			}
+			for i := 0; i < 100; i++ {
+				result.Images = append(result.Images, unversioned.ImageResult{
+					Image:  fmt.Sprintf("docker.io/library/image%d", i),
+					Result: unversioned.ImageRemoved,
+				})
+			}
+			for i := 0; i < tc.patterns; i++ {
+				pattern := fmt.Sprintf("%d/%s", i, strings.Repeat("a", tc.patternLength))
+				result.Exclusions[pattern] = int64(i + 1)
+			}
+
+			data, err := EncodeNodeResult(result)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data) > maxTerminationMessageSize {
+				t.Fatalf("encoded result is %d bytes, limit is %d", len(data), maxTerminationMessageSize)
+			}
+
+			var decoded unversioned.NodeResult
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatal(err)
+			}
+			if decoded.Removed != 100 || decoded.Excluded != int64(tc.patterns) || decoded.Errors != 3 {
+				t.Errorf("expected the counts to be kept, got %+v", decoded)
+			}
+			if len(decoded.Images) != 0 || !decoded.ImagesTruncated {
+				t.Errorf("expected the images to be left out and marked as truncated, got %d images", len(decoded.Images))
+			}
+			if len(result.Exclusions) != tc.patterns {
+				t.Error("expected the result passed in to be unchanged")
+			}
+
+			if !tc.wantSome {
+				if len(decoded.Exclusions) != 0 {
+					t.Errorf("expected no exclusions, got %d", len(decoded.Exclusions))
+				}
+				return
+			}
+			if len(decoded.Exclusions) == 0 || len(decoded.Exclusions) >= tc.patterns {
+				t.Fatalf("expected the exclusions to be cut short, got %d", len(decoded.Exclusions))
+			}
+			// the patterns that protected the most images are kept
+			for pattern, count := range result.Exclusions {
+				if _, ok := decoded.Exclusions[pattern]; !ok && count > int64(tc.patterns-len(decoded.Exclusions)) {
+					t.Errorf("expected pattern %q protecting %d images to be kept", pattern, count)
+				}
+			}
+		})
+	}
+}
+
+func TestExcludedBy(t *testing.T) {
+	excluded, err := imagepattern.NewSet([]string{
+		"docker.io/library/*",
+		"docker.io/library/alpine:*",
+		"docker.io/library/alpine:3",
+		"sha256:1234",
+		"ghcr.io/eraser-dev/remover:*",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	idToImageMap := map[string]unversioned.Image{
+		"sha256:aaaa": {ImageID: "sha256:aaaa", Names: []string{"docker.io/library/alpine:3"}},
+		"sha256:bbbb": {ImageID: "sha256:bbbb", Names: []string{"quay.io/other:1"}, Digests: []string{"sha256:1234"}},
+		"sha256:cccc": {ImageID: "sha256:cccc", Names: []string{"quay.io/other:2"}},
+	}
+
+	testCases := map[string][]string{
+		"sha256:aaaa": {"docker.io/library/*", "docker.io/library/alpine:*", "docker.io/library/alpine:3"},
+		"sha256:bbbb": {"sha256:1234"},
+		"sha256:cccc": nil,
+	}
+
+	for img, expected := range testCases {
+		patterns := ExcludedBy(excluded, img, idToImageMap)
+		if !reflect.DeepEqual(patterns, expected) {
+			t.Errorf("%s: expected %v, got %v", img, expected, patterns)
+		}
+		if IsExcluded(excluded, img, idToImageMap) != (len(expected) > 0) {
+			t.Errorf("%s: IsExcluded does not agree with ExcludedBy", img)
+		}
+	}
+}
+
+func TestReadConfigMap(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.json":    `{"excluded": ["docker.io/library/*"]}`,
+		"b.json":    `{"excluded": ["ghcr.io/eraser-dev/remover:*"]}`,
+		"notes.txt": `not a list`,
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	images, err := readConfigMap(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"docker.io/library/*", "ghcr.io/eraser-dev/remover:*"}
+	if !reflect.DeepEqual(images, expected) {
+		t.Errorf("expected %v, got %v", expected, images)
+	}
+}
+
+func TestExclusionCounts(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "exclusionCounts")
+
+	counts, err := ReadExclusionCounts(path)
+	if err != nil || counts != nil {
+		t.Fatalf("expected no counts before they are written, got %v, %v", counts, err)
+	}
+
+	expected := map[string]int64{"docker.io/library/*": 3}
+	if err := WriteExclusionCounts(path, expected); err != nil {
+		t.Fatal(err)
+	}
+
+	counts, err = ReadExclusionCounts(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(counts, expected) {
+		t.Errorf("expected %v, got %v", expected, counts)
+	}
+}
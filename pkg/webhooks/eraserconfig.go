@@ -0,0 +1,77 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/yaml"
+
+	"github.com/eraser-dev/eraser/api/unversioned/config"
+	"github.com/eraser-dev/eraser/api/v1alpha3"
+	v1alpha3Config "github.com/eraser-dev/eraser/api/v1alpha3/config"
+)
+
+//+kubebuilder:webhook:path=/mutate-eraser-sh-v1alpha3-eraserconfig,mutating=true,failurePolicy=fail,sideEffects=None,groups=eraser.sh,resources=eraserconfigs,verbs=create;update,versions=v1alpha3,name=meraserconfig.eraser.sh,admissionReviewVersions=v1
+
+// eraserConfigDefaulter fills the fields an EraserConfig leaves unset with
+// the same defaults as the configuration file, so the stored resource shows
+// the configuration the manager will use.
+type eraserConfigDefaulter struct{}
+
+func (d *eraserConfigDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	eraserConfig, ok := obj.(*v1alpha3.EraserConfig)
+	if !ok {
+		return fmt.Errorf("expected an EraserConfig, got %T", obj)
+	}
+
+	b, err := json.Marshal(eraserConfig)
+	if err != nil {
+		return err
+	}
+
+	// an EraserConfig that cannot be read is left as it is, for the
+	// validating webhook to reject with a better message.
+	defaults := v1alpha3Config.Default()
+	if err := yaml.Unmarshal(b, defaults); err != nil {
+		return nil
+	}
+
+	eraserConfig.Manager = defaults.Manager
+	eraserConfig.Components = defaults.Components
+	return nil
+}
+
+//+kubebuilder:webhook:path=/validate-eraser-sh-v1alpha3-eraserconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=eraser.sh,resources=eraserconfigs,verbs=create;update,versions=v1alpha3,name=veraserconfig.eraser.sh,admissionReviewVersions=v1
+
+// eraserConfigValidator rejects EraserConfigs the manager would not accept,
+// with the same checks as for the configuration file.
+type eraserConfigValidator struct{}
+
+func (v *eraserConfigValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, validateEraserConfig(obj)
+}
+
+func (v *eraserConfigValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return nil, validateEraserConfig(newObj)
+}
+
+func (v *eraserConfigValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateEraserConfig(obj runtime.Object) error {
+	eraserConfig, ok := obj.(*v1alpha3.EraserConfig)
+	if !ok {
+		return fmt.Errorf("expected an EraserConfig, got %T", obj)
+	}
+
+	cfg, err := config.ParseResource(eraserConfig)
+	if err != nil {
+		return err
+	}
+
+	return config.Validate(cfg)
+}
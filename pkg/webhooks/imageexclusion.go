@@ -0,0 +1,59 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	eraserv1 "github.com/eraser-dev/eraser/api/v1"
+)
+
+//+kubebuilder:webhook:path=/validate-eraser-sh-v1-imageexclusion,mutating=false,failurePolicy=fail,sideEffects=None,groups=eraser.sh,resources=imageexclusions,verbs=create;update,versions=v1,name=vimageexclusion.eraser.sh,admissionReviewVersions=v1
+
+// imageExclusionValidator rejects ImageExclusions with patterns that the
+// collector and remover could not use. One such pattern would stop every job.
+type imageExclusionValidator struct{}
+
+func (v *imageExclusionValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	exclusion, ok := obj.(*eraserv1.ImageExclusion)
+	if !ok {
+		return nil, fmt.Errorf("expected an ImageExclusion, got %T", obj)
+	}
+
+	return nil, validateImageExclusion(exclusion)
+}
+
+func (v *imageExclusionValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldExclusion, ok := oldObj.(*eraserv1.ImageExclusion)
+	if !ok {
+		return nil, fmt.Errorf("expected an ImageExclusion, got %T", oldObj)
+	}
+	exclusion, ok := newObj.(*eraserv1.ImageExclusion)
+	if !ok {
+		return nil, fmt.Errorf("expected an ImageExclusion, got %T", newObj)
+	}
+
+	if slices.Equal(oldExclusion.Spec.Patterns, exclusion.Spec.Patterns) {
+		return nil, nil
+	}
+
+	return nil, validateImageExclusion(exclusion)
+}
+
+func (v *imageExclusionValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateImageExclusion(exclusion *eraserv1.ImageExclusion) error {
+	errs := validateImages(field.NewPath("spec", "patterns"), exclusion.Spec.Patterns)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(eraserv1.GroupVersion.WithKind("ImageExclusion").GroupKind(), exclusion.Name, errs)
+}
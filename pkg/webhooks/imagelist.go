@@ -0,0 +1,109 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/distribution/reference"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	eraserv1 "github.com/eraser-dev/eraser/api/v1"
+	"github.com/eraser-dev/eraser/pkg/imagepattern"
+)
+
+//+kubebuilder:webhook:path=/validate-eraser-sh-v1-imagelist,mutating=false,failurePolicy=fail,sideEffects=None,groups=eraser.sh,resources=imagelists,verbs=create;update,versions=v1,name=vimagelist.eraser.sh,admissionReviewVersions=v1
+
+// imageListValidator rejects ImageLists that the remover could not use.
+type imageListValidator struct{}
+
+func (v *imageListValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	imageList, ok := obj.(*eraserv1.ImageList)
+	if !ok {
+		return nil, fmt.Errorf("expected an ImageList, got %T", obj)
+	}
+
+	return nil, validateImageList(imageList)
+}
+
+func (v *imageListValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldList, ok := oldObj.(*eraserv1.ImageList)
+	if !ok {
+		return nil, fmt.Errorf("expected an ImageList, got %T", oldObj)
+	}
+	imageList, ok := newObj.(*eraserv1.ImageList)
+	if !ok {
+		return nil, fmt.Errorf("expected an ImageList, got %T", newObj)
+	}
+
+	// ImageLists created before the webhook was installed can still be
+	// updated, as long as the images are left alone.
+	if slices.Equal(oldList.Spec.Images, imageList.Spec.Images) {
+		return nil, nil
+	}
+
+	return nil, validateImageList(imageList)
+}
+
+func (v *imageListValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func validateImageList(imageList *eraserv1.ImageList) error {
+	errs := validateImages(field.NewPath("spec", "images"), imageList.Spec.Images)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(eraserv1.GroupVersion.WithKind("ImageList").GroupKind(), imageList.Name, errs)
+}
+
+// validateImages checks that every image is "*" or a pattern the remover can
+// use, and that no image is listed twice.
+func validateImages(path *field.Path, images []string) field.ErrorList {
+	var errs field.ErrorList
+
+	seen := make(map[string]struct{}, len(images))
+	for i, img := range images {
+		p := path.Index(i)
+
+		if _, ok := seen[img]; ok {
+			errs = append(errs, field.Duplicate(p, img))
+			continue
+		}
+		seen[img] = struct{}{}
+
+		if img == "*" {
+			continue
+		}
+		if err := validatePattern(img); err != nil {
+			errs = append(errs, field.Invalid(p, img, err.Error()))
+		}
+	}
+
+	return errs
+}
+
+// validatePattern checks that a literal is an image reference or an image
+// ID, and that a glob would be one if its wildcards were names.
+func validatePattern(pattern string) error {
+	p, err := imagepattern.Parse(pattern)
+	if err != nil {
+		return err
+	}
+
+	switch p.Kind() {
+	case imagepattern.Literal:
+		// image IDs, with or without the sha256: prefix, parse as references
+		// too
+		_, err = reference.Parse(pattern)
+	case imagepattern.Glob:
+		_, err = reference.Parse(strings.NewReplacer("*", "x", "?", "x").Replace(pattern))
+	}
+
+	return err
+}
@@ -0,0 +1,35 @@
+// Package webhooks implements the admission webhooks served by the manager.
+package webhooks
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	eraserv1 "github.com/eraser-dev/eraser/api/v1"
+	"github.com/eraser-dev/eraser/api/v1alpha3"
+)
+
+// SetupWithManager registers the validating webhooks, and the defaulting and
+// conversion webhooks of EraserConfig, with the manager's webhook server.
+func SetupWithManager(mgr ctrl.Manager) error {
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&eraserv1.ImageList{}).
+		WithValidator(&imageListValidator{}).
+		Complete(); err != nil {
+		return err
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&eraserv1.ImageExclusion{}).
+		WithValidator(&imageExclusionValidator{}).
+		Complete(); err != nil {
+		return err
+	}
+
+	// other versions of EraserConfig are converted to v1alpha3, the hub,
+	// before they are defaulted and validated.
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&v1alpha3.EraserConfig{}).
+		WithDefaulter(&eraserConfigDefaulter{}).
+		WithValidator(&eraserConfigValidator{}).
+		Complete()
+}
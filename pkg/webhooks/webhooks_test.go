@@ -0,0 +1,265 @@
+package webhooks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	eraserv1 "github.com/eraser-dev/eraser/api/v1"
+	"github.com/eraser-dev/eraser/api/v1alpha3"
+)
+
+func TestValidateImages(t *testing.T) {
+	const id = "a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2"
+
+	cases := map[string]struct {
+		images []string
+		errs   int
+	}{
+		"all":                {images: []string{"*"}},
+		"names":              {images: []string{"nginx", "docker.io/library/nginx:1.25", "localhost:5000/app"}},
+		"digest":             {images: []string{"nginx@sha256:" + id}},
+		"image ids":          {images: []string{"sha256:" + id, id}},
+		"all and names":      {images: []string{"*", "nginx"}},
+		"empty":              {images: []string{""}, errs: 1},
+		"uppercase":          {images: []string{"Nginx"}, errs: 1},
+		"whitespace":         {images: []string{" nginx"}, errs: 1},
+		"tag wildcard":       {images: []string{"nginx:*", "app:v1.?"}},
+		"repo wildcard":      {images: []string{"docker.io/library/*", "*/internal/**"}},
+		"invalid wildcard":   {images: []string{"Nginx:*"}, errs: 1},
+		"regexp":             {images: []string{`/.*:v1\.[0-9]+/`}},
+		"invalid regexp":     {images: []string{"/nginx(/"}, errs: 1},
+		"duplicate":          {images: []string{"nginx", "alpine", "nginx"}, errs: 1},
+		"duplicate wildcard": {images: []string{"*", "*"}, errs: 1},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			errs := validateImages(field.NewPath("spec", "images"), tc.images)
+			if len(errs) != tc.errs {
+				t.Errorf("expected %d errors, got %v", tc.errs, errs)
+			}
+		})
+	}
+}
+
+func TestImageListValidator(t *testing.T) {
+	ctx := context.Background()
+	v := &imageListValidator{}
+
+	valid := &eraserv1.ImageList{
+		ObjectMeta: metav1.ObjectMeta{Name: "imagelist"},
+		Spec:       eraserv1.ImageListSpec{Images: []string{"nginx"}},
+	}
+	invalid := valid.DeepCopy()
+	invalid.Spec.Images = []string{"nginx", "nginx"}
+
+	if _, err := v.ValidateCreate(ctx, valid); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := v.ValidateCreate(ctx, invalid); err == nil {
+		t.Error("expected an error for duplicate images")
+	}
+	if _, err := v.ValidateUpdate(ctx, valid, invalid); err == nil {
+		t.Error("expected an error when duplicate images are added")
+	}
+
+	// an ImageList that is already invalid can be updated if the images are
+	// left alone
+	relabeled := invalid.DeepCopy()
+	relabeled.Labels = map[string]string{"team": "a"}
+	if _, err := v.ValidateUpdate(ctx, invalid, relabeled); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestImageExclusionValidator(t *testing.T) {
+	ctx := context.Background()
+	v := &imageExclusionValidator{}
+
+	valid := &eraserv1.ImageExclusion{
+		ObjectMeta: metav1.ObjectMeta{Name: "base-images"},
+		Spec:       eraserv1.ImageExclusionSpec{Patterns: []string{"docker.io/library/*"}},
+	}
+	invalid := valid.DeepCopy()
+	invalid.Spec.Patterns = []string{"/library(/"}
+
+	if _, err := v.ValidateCreate(ctx, valid); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := v.ValidateCreate(ctx, invalid); err == nil {
+		t.Error("expected an error for an invalid regular expression")
+	}
+	if _, err := v.ValidateUpdate(ctx, valid, invalid); err == nil {
+		t.Error("expected an error when an invalid pattern is added")
+	}
+}
+
+func TestEraserConfigValidator(t *testing.T) {
+	ctx := context.Background()
+	v := &eraserConfigValidator{}
+
+	cases := map[string]struct {
+		mutate  func(*v1alpha3.EraserConfig)
+		wantErr bool
+	}{
+		"defaults": {mutate: func(*v1alpha3.EraserConfig) {}},
+		"runtime address": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Manager.Runtime = v1alpha3.RuntimeSpec{Name: v1alpha3.RuntimeCrio, Address: "unix:///run/crio/crio.sock"}
+			},
+		},
+		"runtime address scheme": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Manager.Runtime = v1alpha3.RuntimeSpec{Name: v1alpha3.RuntimeContainerd, Address: "http://localhost"}
+			},
+			wantErr: true,
+		},
+		"node filter selector": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Manager.NodeFilter = v1alpha3.NodeFilterConfig{Type: "include", Selectors: []string{"pool in (a, b"}}
+			},
+			wantErr: true,
+		},
+		"node filter type": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Manager.NodeFilter = v1alpha3.NodeFilterConfig{Type: "only"}
+			},
+			wantErr: true,
+		},
+		"success ratio": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Manager.ImageJob.SuccessRatio = 1.5
+			},
+			wantErr: true,
+		},
+		"unused for": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Manager.CollectorPolicy.UnusedFor = v1alpha3.Duration(-time.Hour)
+			},
+			wantErr: true,
+		},
+		"keep last": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Manager.CollectorPolicy.KeepLast = -1
+			},
+			wantErr: true,
+		},
+		"disk usage thresholds": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Manager.CollectorPolicy.DiskUsage = v1alpha3.DiskUsagePolicy{HighThresholdPercent: 60, LowThresholdPercent: 80}
+			},
+			wantErr: true,
+		},
+		"disk pressure cooldown": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Manager.Scheduling.DiskPressure = v1alpha3.DiskPressureTrigger{Enabled: true, Cooldown: v1alpha3.Duration(-time.Minute)}
+			},
+			wantErr: true,
+		},
+		"remover concurrency": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Components.Remover.Concurrency = -1
+			},
+			wantErr: true,
+		},
+		"remover timeout": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Components.Remover.Timeout = v1alpha3.Duration(-time.Minute)
+			},
+			wantErr: true,
+		},
+		"scanner config": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Components.Scanner.Config = ptr.To("severities: [CRITICAL")
+			},
+			wantErr: true,
+		},
+		"scanner config fields": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Components.Scanner.Config = ptr.To("concurrency: 2\nvulnerabilities:\n  severities: [CRITICAL, HIGH]\ntimeout:\n  total: 1h\n")
+			},
+		},
+		"scanner config type": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Components.Scanner.Config = ptr.To("concurrency: x")
+			},
+			wantErr: true,
+		},
+		"scanner config list type": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Components.Scanner.Config = ptr.To("vulnerabilities:\n  severities: HIGH\n")
+			},
+			wantErr: true,
+		},
+		"scanner config unknown key": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Components.Scanner.Config = ptr.To("cacheDirectory: /var/lib/trivy")
+			},
+			wantErr: true,
+		},
+		"custom scanner config": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Components.Scanner.Image = v1alpha3.RepoTag{Repo: "example.com/my-scanner", Tag: "v1"}
+				c.Components.Scanner.Config = ptr.To("threshold: 7")
+			},
+		},
+		"schedule": {
+			mutate: func(c *v1alpha3.EraserConfig) {
+				c.Manager.Scheduling.TimeZone = "Mars/Olympus"
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &v1alpha3.EraserConfig{ObjectMeta: metav1.ObjectMeta{Name: "eraser-config"}}
+			tc.mutate(c)
+
+			_, err := v.ValidateCreate(ctx, c)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("wantErr %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestEraserConfigDefaulter(t *testing.T) {
+	ctx := context.Background()
+	d := &eraserConfigDefaulter{}
+
+	c := &v1alpha3.EraserConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "eraser-config", Labels: map[string]string{"team": "a"}},
+		Manager: v1alpha3.ManagerConfig{
+			Scheduling: v1alpha3.ScheduleConfig{Cron: "0 2 * * *"},
+		},
+		Components: v1alpha3.Components{
+			Remover: v1alpha3.RemoverConfig{Concurrency: 2},
+		},
+		Status: v1alpha3.EraserConfigStatus{Accepted: true},
+	}
+
+	if err := d.Default(ctx, c); err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Manager.Scheduling.Cron != "0 2 * * *" || c.Components.Remover.Concurrency != 2 {
+		t.Errorf("expected the fields that are set to be kept, got %+v", c)
+	}
+	if c.Manager.ImageJob.SuccessRatio != 1.0 || c.Manager.Runtime.Name != v1alpha3.RuntimeContainerd || c.Components.Remover.Image.Repo == "" {
+		t.Errorf("expected the fields that are not set to take their defaults, got %+v", c)
+	}
+	if c.Name != "eraser-config" || c.Labels["team"] != "a" || !c.Status.Accepted {
+		t.Errorf("expected the metadata and status to be kept, got %+v", c)
+	}
+
+	// the defaulted resource is still valid
+	if _, err := (&eraserConfigValidator{}).ValidateCreate(ctx, c); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}